@@ -0,0 +1,12 @@
+# Fetch this year's outdoor rides of at least 20 km.
+data "strava_activities" "rides" {
+  after        = 1672531200
+  sport_types  = ["Ride", "GravelRide", "MountainBikeRide"]
+  trainer      = false
+  min_distance = 20000
+  max_items    = 500
+}
+
+output "ride_distance" {
+  value = sum([for activity in data.strava_activities.rides.activities : activity.distance])
+}
@@ -0,0 +1,9 @@
+# Fetch a race with all its segment efforts.
+data "strava_activity" "race" {
+  id                  = 8529483505
+  include_all_efforts = true
+}
+
+output "best_efforts" {
+  value = { for effort in data.strava_activity.race.best_efforts : effort.name => effort.elapsed_time }
+}
@@ -0,0 +1,8 @@
+# Count the comments on a club ride per athlete.
+data "strava_activity_comments" "club_ride" {
+  activity_id = 8529483505
+}
+
+output "comments_per_athlete" {
+  value = { for comment in data.strava_activity_comments.club_ride.comments : comment.athlete_id => comment.id... }
+}
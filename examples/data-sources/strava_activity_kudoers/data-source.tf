@@ -0,0 +1,8 @@
+# Count the kudos on a club ride.
+data "strava_activity_kudoers" "club_ride" {
+  activity_id = 8529483505
+}
+
+output "kudos" {
+  value = length(data.strava_activity_kudoers.club_ride.kudoers)
+}
@@ -0,0 +1,8 @@
+# Fetch the laps of an interval session.
+data "strava_activity_laps" "intervals" {
+  activity_id = 8529483505
+}
+
+output "lap_paces" {
+  value = [for lap in data.strava_activity_laps.intervals.laps : 1000 / lap.average_speed]
+}
@@ -0,0 +1,10 @@
+# Fetch the heart rate of an activity over time, reduced to a few hundred points.
+data "strava_activity_streams" "race" {
+  activity_id = 8529483505
+  keys        = ["time", "heartrate"]
+  resolution  = "low"
+}
+
+output "max_heartrate" {
+  value = max(data.strava_activity_streams.race.heartrate.data...)
+}
@@ -0,0 +1,12 @@
+# Fetch the time spent in each heart rate zone during a race.
+data "strava_activity_zones" "race" {
+  activity_id = 8529483505
+}
+
+output "heartrate_zone_times" {
+  value = flatten([
+    for zone in data.strava_activity_zones.race.zones : [
+      for bucket in zone.distribution_buckets : bucket.time
+    ] if zone.type == "heartrate"
+  ])
+}
@@ -0,0 +1,6 @@
+# Fetch the athlete the provider acts on behalf of.
+data "strava_athlete" "me" {}
+
+output "ftp" {
+  value = data.strava_athlete.me.ftp
+}
@@ -0,0 +1,12 @@
+# Fetch the activity totals of the athlete the provider acts on behalf of.
+data "strava_athlete_stats" "me" {}
+
+output "ytd_ride_distance" {
+  value = data.strava_athlete_stats.me.ytd_ride_totals.distance
+}
+
+# Fetch the totals of another athlete configured in an athlete block.
+data "strava_athlete_stats" "alice" {
+  provider        = strava.team
+  athlete_profile = "alice"
+}
@@ -0,0 +1,6 @@
+# Fetch the heart rate and power zones of the athlete the provider acts on behalf of.
+data "strava_athlete_zones" "me" {}
+
+output "heart_rate_zone_maximums" {
+  value = data.strava_athlete_zones.me.heart_rate.zones[*].max
+}
@@ -0,0 +1,11 @@
+# Build the link athletes follow to grant access to their activities.
+data "strava_authorization_url" "example" {
+  redirect_uri    = "https://example.com/strava/callback"
+  scopes          = ["read", "activity:read_all"]
+  approval_prompt = "force"
+  state           = "production"
+}
+
+output "authorization_url" {
+  value = data.strava_authorization_url.example.url
+}
@@ -0,0 +1,4 @@
+# Look up a push subscription by its callback URL.
+data "strava_push_subscription" "example" {
+  callback_url = "http://a-valid.com/url"
+}
@@ -2,4 +2,54 @@
 provider "strava" {
   client_id     = "5"
   client_secret = "7b2946535949ae70f015d696d8ac602830ece412"
+
+  # Retry throttled and failed requests, waiting for the next rate limit
+  # window when the short-term budget is used up.
+  max_retries        = 5
+  retry_max_wait     = "1m"
+  respect_rate_limit = true
+}
+
+# Reaching Strava through a corporate proxy that terminates TLS
+provider "strava" {
+  alias = "proxied"
+
+  http {
+    timeout        = "30s"
+    proxy_url      = "http://proxy.example.com:3128"
+    ca_bundle_file = "/etc/ssl/certs/corporate-ca.pem"
+  }
+}
+
+# Acting on behalf of an athlete, required by athlete-scoped resources and
+# data sources. The refresh token may also be set with STRAVA_REFRESH_TOKEN.
+provider "strava" {
+  alias = "athlete"
+
+  client_id     = "5"
+  client_secret = "7b2946535949ae70f015d696d8ac602830ece412"
+  refresh_token = "8ac602830ece4127b2946535949ae70f015d696d"
+
+  # Share access tokens and rotated refresh tokens between runs.
+  token_cache_path = "${path.root}/.terraform/strava-tokens"
+}
+
+# Acting on behalf of several athletes, selected with the athlete_profile
+# attribute of athlete-scoped resources and data sources.
+provider "strava" {
+  alias = "team"
+
+  client_id     = "5"
+  client_secret = "7b2946535949ae70f015d696d8ac602830ece412"
+
+  athlete {
+    name          = "alice"
+    refresh_token = var.alice_refresh_token
+    scopes        = ["read", "profile:read_all"]
+  }
+
+  athlete {
+    name          = "bob"
+    refresh_token = var.bob_refresh_token
+  }
 }
@@ -0,0 +1,5 @@
+# Activity can be imported by specifying the activity identifier.
+terraform import strava_activity.gym 8529483505
+
+# The athlete profile of the provider athlete block may follow the identifier.
+terraform import strava_activity.gym 8529483505,alice
@@ -0,0 +1,12 @@
+# Log a recurring gym session. Requires the activity:write scope.
+resource "strava_activity" "gym" {
+  name             = "Tuesday strength session"
+  sport_type       = "WeightTraining"
+  start_date_local = "2023-05-02T18:30:00Z"
+  elapsed_time     = 3600
+  description      = "Squats, deadlifts and core."
+  hide_from_home   = true
+
+  # Strava cannot delete activities, so rename and hide it on destroy.
+  destroy_behavior = "hide"
+}
@@ -0,0 +1,5 @@
+# Athlete profile can be imported by specifying the athlete identifier.
+terraform import strava_athlete_profile.me 12345
+
+# The athlete profile of the provider athlete block may follow the identifier.
+terraform import strava_athlete_profile.me 12345,alice
@@ -0,0 +1,5 @@
+# Manage the weight of the athlete the provider acts on behalf of.
+# Requires the profile:write scope.
+resource "strava_athlete_profile" "me" {
+  weight = 72.5
+}
@@ -0,0 +1,9 @@
+# Exchange the code from the authorization redirect for the athlete's tokens.
+resource "strava_oauth_token" "example" {
+  authorization_code = var.authorization_code
+  scopes             = ["read", "activity:read_all"]
+}
+
+output "athlete_id" {
+  value = strava_oauth_token.example.athlete_id
+}
@@ -1,2 +1,9 @@
 # Push subscription can be imported by specifying the subscription identifier and the verify token.
 terraform import strava_push_subscription.example 12345,STRAVA
+
+# The verify token may be omitted, in which case the next apply records the
+# token in config as is, since Strava does not expose it.
+terraform import strava_push_subscription.example 12345
+
+# The subscription can also be looked up by its callback URL.
+terraform import strava_push_subscription.example callback_url=http://a-valid.com/url
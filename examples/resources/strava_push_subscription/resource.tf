@@ -2,4 +2,10 @@
 resource "strava_push_subscription" "example" {
   callback_url = "http://a-valid.com/url"
   verify_token = "STRAVA"
+
+  # Optionally verify the callback endpoint answers the handshake before
+  # the subscription is created.
+  preflight_check {
+    timeout = "2s"
+  }
 }
@@ -0,0 +1,372 @@
+package strava
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &activitiesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &activitiesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &activitiesDataSource{}
+	_ withRequiredScopes                      = &activitiesDataSource{}
+)
+
+// NewActivitiesDataSource is a helper function to simplify the provider implementation.
+func NewActivitiesDataSource() datasource.DataSource {
+	return &activitiesDataSource{}
+}
+
+// activitiesDataSource is the data source implementation.
+type activitiesDataSource struct {
+	athletes athleteClients
+}
+
+// activitiesDataSourceModel maps the data source schema data.
+type activitiesDataSourceModel struct {
+	AthleteProfile types.String           `tfsdk:"athlete_profile"`
+	ID             types.String           `tfsdk:"id"`
+	Before         types.Int64            `tfsdk:"before"`
+	After          types.Int64            `tfsdk:"after"`
+	MaxItems       types.Int64            `tfsdk:"max_items"`
+	SportTypes     types.Set              `tfsdk:"sport_types"`
+	Commute        types.Bool             `tfsdk:"commute"`
+	Trainer        types.Bool             `tfsdk:"trainer"`
+	MinDistance    types.Float64          `tfsdk:"min_distance"`
+	MinMovingTime  types.Int64            `tfsdk:"min_moving_time"`
+	Activities     []activitySummaryModel `tfsdk:"activities"`
+}
+
+// activitySummaryModel maps activity summary schema data.
+type activitySummaryModel struct {
+	ID                 types.Int64   `tfsdk:"id"`
+	Name               types.String  `tfsdk:"name"`
+	SportType          types.String  `tfsdk:"sport_type"`
+	StartDate          types.String  `tfsdk:"start_date"`
+	StartDateLocal     types.String  `tfsdk:"start_date_local"`
+	Timezone           types.String  `tfsdk:"timezone"`
+	Distance           types.Float64 `tfsdk:"distance"`
+	MovingTime         types.Int64   `tfsdk:"moving_time"`
+	ElapsedTime        types.Int64   `tfsdk:"elapsed_time"`
+	TotalElevationGain types.Float64 `tfsdk:"total_elevation_gain"`
+	ElevHigh           types.Float64 `tfsdk:"elev_high"`
+	ElevLow            types.Float64 `tfsdk:"elev_low"`
+	AverageSpeed       types.Float64 `tfsdk:"average_speed"`
+	MaxSpeed           types.Float64 `tfsdk:"max_speed"`
+	Trainer            types.Bool    `tfsdk:"trainer"`
+	Commute            types.Bool    `tfsdk:"commute"`
+	Manual             types.Bool    `tfsdk:"manual"`
+	Private            types.Bool    `tfsdk:"private"`
+	GearID             types.String  `tfsdk:"gear_id"`
+	SummaryPolyline    types.String  `tfsdk:"summary_polyline"`
+}
+
+// activityFilter selects activities on the client side, as Strava only
+// filters them by date.
+type activityFilter struct {
+	SportTypes    map[string]bool
+	Commute       *bool
+	Trainer       *bool
+	MinDistance   float64
+	MinMovingTime int64
+}
+
+// Metadata returns the data source type name.
+func (d *activitiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activities"
+}
+
+// Schema defines the schema for the data source.
+func (d *activitiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the activities of the athlete the provider acts on behalf of, paging through them as needed. " +
+			"Requires the provider refresh_token with the activity:read scope, or activity:read_all to include private activities.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"before": schema.Int64Attribute{
+				Description: "Only include activities started before this Unix timestamp.",
+				Optional:    true,
+			},
+			"after": schema.Int64Attribute{
+				Description: "Only include activities started after this Unix timestamp.",
+				Optional:    true,
+			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of activities to return. Defaults to all matching activities.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"sport_types": schema.SetAttribute{
+				Description: "Only include activities of these sport types.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"commute": schema.BoolAttribute{
+				Description: "Only include activities that are, or are not, commutes.",
+				Optional:    true,
+			},
+			"trainer": schema.BoolAttribute{
+				Description: "Only include activities that were, or were not, done on a trainer.",
+				Optional:    true,
+			},
+			"min_distance": schema.Float64Attribute{
+				Description: "Only include activities at least this long, in meters.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"min_moving_time": schema.Int64Attribute{
+				Description: "Only include activities with at least this moving time, in seconds.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"activities": schema.ListNestedAttribute{
+				Description: "Matching activities, in the order Strava returns them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Activity ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the activity.",
+							Computed:    true,
+						},
+						"sport_type": schema.StringAttribute{
+							Description: "Sport type of the activity.",
+							Computed:    true,
+						},
+						"start_date": schema.StringAttribute{
+							Description: "Date and time the activity started, in UTC.",
+							Computed:    true,
+						},
+						"start_date_local": schema.StringAttribute{
+							Description: "Local date and time the activity started.",
+							Computed:    true,
+						},
+						"timezone": schema.StringAttribute{
+							Description: "Time zone of the activity.",
+							Computed:    true,
+						},
+						"distance": schema.Float64Attribute{
+							Description: "Distance, in meters.",
+							Computed:    true,
+						},
+						"moving_time": schema.Int64Attribute{
+							Description: "Moving time, in seconds.",
+							Computed:    true,
+						},
+						"elapsed_time": schema.Int64Attribute{
+							Description: "Elapsed time, in seconds.",
+							Computed:    true,
+						},
+						"total_elevation_gain": schema.Float64Attribute{
+							Description: "Elevation gain, in meters.",
+							Computed:    true,
+						},
+						"elev_high": schema.Float64Attribute{
+							Description: "Highest elevation, in meters.",
+							Computed:    true,
+						},
+						"elev_low": schema.Float64Attribute{
+							Description: "Lowest elevation, in meters.",
+							Computed:    true,
+						},
+						"average_speed": schema.Float64Attribute{
+							Description: "Average speed, in meters per second.",
+							Computed:    true,
+						},
+						"max_speed": schema.Float64Attribute{
+							Description: "Maximum speed, in meters per second.",
+							Computed:    true,
+						},
+						"trainer": schema.BoolAttribute{
+							Description: "Whether the activity was done on a trainer.",
+							Computed:    true,
+						},
+						"commute": schema.BoolAttribute{
+							Description: "Whether the activity is a commute.",
+							Computed:    true,
+						},
+						"manual": schema.BoolAttribute{
+							Description: "Whether the activity was created manually.",
+							Computed:    true,
+						},
+						"private": schema.BoolAttribute{
+							Description: "Whether the activity is private.",
+							Computed:    true,
+						},
+						"gear_id": schema.StringAttribute{
+							Description: "ID of the gear used.",
+							Computed:    true,
+						},
+						"summary_polyline": schema.StringAttribute{
+							Description: "Encoded polyline of the route, at reduced resolution.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *activitiesDataSource) requiredScopes() []string {
+	return []string{"activity:read"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *activitiesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *activitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state activitiesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(state.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := activityFilter{
+		Commute:       state.Commute.ValueBoolPointer(),
+		Trainer:       state.Trainer.ValueBoolPointer(),
+		MinDistance:   state.MinDistance.ValueFloat64(),
+		MinMovingTime: state.MinMovingTime.ValueInt64(),
+	}
+
+	if !state.SportTypes.IsNull() {
+		var sportTypes []string
+		resp.Diagnostics.Append(state.SportTypes.ElementsAs(ctx, &sportTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		filter.SportTypes = map[string]bool{}
+		for _, sportType := range sportTypes {
+			filter.SportTypes[sportType] = true
+		}
+	}
+
+	activities, err := client.listAthleteActivities(
+		ctx,
+		state.Before.ValueInt64(),
+		state.After.ValueInt64(),
+		filter.matches,
+		int(state.MaxItems.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Activities",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Activities = []activitySummaryModel{}
+	for _, activity := range activities {
+		state.Activities = append(state.Activities, newActivitySummaryModel(activity))
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *activitiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
+
+// matches reports whether the activity passes all filters.
+func (f activityFilter) matches(activity summaryActivity) bool {
+	if f.SportTypes != nil && !f.SportTypes[activity.SportType] {
+		return false
+	}
+
+	if f.Commute != nil && activity.Commute != *f.Commute {
+		return false
+	}
+
+	if f.Trainer != nil && activity.Trainer != *f.Trainer {
+		return false
+	}
+
+	return activity.Distance >= f.MinDistance && activity.MovingTime >= f.MinMovingTime
+}
+
+// newActivitySummaryModel maps an activity summary to its schema data.
+func newActivitySummaryModel(activity summaryActivity) activitySummaryModel {
+	return activitySummaryModel{
+		ID:                 types.Int64Value(activity.ID),
+		Name:               types.StringValue(activity.Name),
+		SportType:          types.StringValue(activity.SportType),
+		StartDate:          types.StringValue(activity.StartDate),
+		StartDateLocal:     types.StringValue(activity.StartDateLocal),
+		Timezone:           types.StringValue(activity.Timezone),
+		Distance:           types.Float64Value(activity.Distance),
+		MovingTime:         types.Int64Value(activity.MovingTime),
+		ElapsedTime:        types.Int64Value(activity.ElapsedTime),
+		TotalElevationGain: types.Float64Value(activity.TotalElevationGain),
+		ElevHigh:           types.Float64PointerValue(activity.ElevHigh),
+		ElevLow:            types.Float64PointerValue(activity.ElevLow),
+		AverageSpeed:       types.Float64Value(activity.AverageSpeed),
+		MaxSpeed:           types.Float64Value(activity.MaxSpeed),
+		Trainer:            types.BoolValue(activity.Trainer),
+		Commute:            types.BoolValue(activity.Commute),
+		Manual:             types.BoolValue(activity.Manual),
+		Private:            types.BoolValue(activity.Private),
+		GearID:             types.StringPointerValue(activity.GearID),
+		SummaryPolyline:    types.StringPointerValue(activity.Map.SummaryPolyline),
+	}
+}
@@ -0,0 +1,187 @@
+package strava
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &activityCommentsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &activityCommentsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &activityCommentsDataSource{}
+	_ withRequiredScopes                      = &activityCommentsDataSource{}
+)
+
+// NewActivityCommentsDataSource is a helper function to simplify the provider implementation.
+func NewActivityCommentsDataSource() datasource.DataSource {
+	return &activityCommentsDataSource{}
+}
+
+// activityCommentsDataSource is the data source implementation.
+type activityCommentsDataSource struct {
+	athletes athleteClients
+}
+
+// activityCommentsDataSourceModel maps the data source schema data.
+type activityCommentsDataSourceModel struct {
+	AthleteProfile types.String           `tfsdk:"athlete_profile"`
+	ID             types.String           `tfsdk:"id"`
+	ActivityID     types.Int64            `tfsdk:"activity_id"`
+	MaxItems       types.Int64            `tfsdk:"max_items"`
+	Comments       []activityCommentModel `tfsdk:"comments"`
+}
+
+// activityCommentModel maps activity comment schema data.
+type activityCommentModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Text             types.String `tfsdk:"text"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	AthleteID        types.Int64  `tfsdk:"athlete_id"`
+	AthleteFirstname types.String `tfsdk:"athlete_firstname"`
+	AthleteLastname  types.String `tfsdk:"athlete_lastname"`
+}
+
+// Metadata returns the data source type name.
+func (d *activityCommentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity_comments"
+}
+
+// Schema defines the schema for the data source.
+func (d *activityCommentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the comments on an activity, paging through all of them. " +
+			"Requires the provider refresh_token with the activity:read scope, or activity:read_all for private activities.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"activity_id": schema.Int64Attribute{
+				Description: "Activity ID.",
+				Required:    true,
+			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of comments to return. Defaults to all comments.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"comments": schema.ListNestedAttribute{
+				Description: "Comments on the activity, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Comment ID.",
+							Computed:    true,
+						},
+						"text": schema.StringAttribute{
+							Description: "Text of the comment.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Date and time the comment was posted.",
+							Computed:    true,
+						},
+						"athlete_id": schema.Int64Attribute{
+							Description: "ID of the athlete who posted the comment.",
+							Computed:    true,
+						},
+						"athlete_firstname": schema.StringAttribute{
+							Description: "First name of the athlete who posted the comment.",
+							Computed:    true,
+						},
+						"athlete_lastname": schema.StringAttribute{
+							Description: "Last name of the athlete who posted the comment.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *activityCommentsDataSource) requiredScopes() []string {
+	return []string{"activity:read"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *activityCommentsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *activityCommentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state activityCommentsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(state.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comments, err := client.listActivityComments(ctx, state.ActivityID.ValueInt64(), int(state.MaxItems.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Activity Comments",
+			"Could not read comments of activity ID "+strconv.FormatInt(state.ActivityID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue("placeholder")
+	state.Comments = []activityCommentModel{}
+	for _, comment := range comments {
+		state.Comments = append(state.Comments, activityCommentModel{
+			ID:               types.Int64Value(comment.ID),
+			Text:             types.StringValue(comment.Text),
+			CreatedAt:        types.StringValue(comment.CreatedAt),
+			AthleteID:        types.Int64Value(comment.Athlete.ID),
+			AthleteFirstname: types.StringValue(comment.Athlete.Firstname),
+			AthleteLastname:  types.StringValue(comment.Athlete.Lastname),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *activityCommentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
@@ -0,0 +1,626 @@
+package strava
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &activityDataSource{}
+	_ datasource.DataSourceWithConfigure      = &activityDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &activityDataSource{}
+	_ withRequiredScopes                      = &activityDataSource{}
+)
+
+// NewActivityDataSource is a helper function to simplify the provider implementation.
+func NewActivityDataSource() datasource.DataSource {
+	return &activityDataSource{}
+}
+
+// activityDataSource is the data source implementation.
+type activityDataSource struct {
+	athletes athleteClients
+}
+
+// activityDataSourceModel maps the data source schema data.
+type activityDataSourceModel struct {
+	AthleteProfile     types.String         `tfsdk:"athlete_profile"`
+	ID                 types.Int64          `tfsdk:"id"`
+	IncludeAllEfforts  types.Bool           `tfsdk:"include_all_efforts"`
+	Name               types.String         `tfsdk:"name"`
+	SportType          types.String         `tfsdk:"sport_type"`
+	StartDate          types.String         `tfsdk:"start_date"`
+	StartDateLocal     types.String         `tfsdk:"start_date_local"`
+	Timezone           types.String         `tfsdk:"timezone"`
+	Distance           types.Float64        `tfsdk:"distance"`
+	MovingTime         types.Int64          `tfsdk:"moving_time"`
+	ElapsedTime        types.Int64          `tfsdk:"elapsed_time"`
+	TotalElevationGain types.Float64        `tfsdk:"total_elevation_gain"`
+	AverageSpeed       types.Float64        `tfsdk:"average_speed"`
+	MaxSpeed           types.Float64        `tfsdk:"max_speed"`
+	Trainer            types.Bool           `tfsdk:"trainer"`
+	Commute            types.Bool           `tfsdk:"commute"`
+	Manual             types.Bool           `tfsdk:"manual"`
+	Private            types.Bool           `tfsdk:"private"`
+	Description        types.String         `tfsdk:"description"`
+	Calories           types.Float64        `tfsdk:"calories"`
+	DeviceName         types.String         `tfsdk:"device_name"`
+	GearID             types.String         `tfsdk:"gear_id"`
+	Gear               *gearSummaryModel    `tfsdk:"gear"`
+	Polyline           types.String         `tfsdk:"polyline"`
+	SummaryPolyline    types.String         `tfsdk:"summary_polyline"`
+	SplitsMetric       []activitySplitModel `tfsdk:"splits_metric"`
+	SplitsStandard     []activitySplitModel `tfsdk:"splits_standard"`
+	Laps               []activityLapModel   `tfsdk:"laps"`
+	SegmentEfforts     []segmentEffortModel `tfsdk:"segment_efforts"`
+	BestEfforts        []segmentEffortModel `tfsdk:"best_efforts"`
+}
+
+// activitySplitModel maps activity split schema data.
+type activitySplitModel struct {
+	Split               types.Int64   `tfsdk:"split"`
+	Distance            types.Float64 `tfsdk:"distance"`
+	ElapsedTime         types.Int64   `tfsdk:"elapsed_time"`
+	MovingTime          types.Int64   `tfsdk:"moving_time"`
+	ElevationDifference types.Float64 `tfsdk:"elevation_difference"`
+	AverageSpeed        types.Float64 `tfsdk:"average_speed"`
+	PaceZone            types.Int64   `tfsdk:"pace_zone"`
+}
+
+// activityLapModel maps activity lap schema data.
+type activityLapModel struct {
+	ID                 types.Int64   `tfsdk:"id"`
+	Name               types.String  `tfsdk:"name"`
+	LapIndex           types.Int64   `tfsdk:"lap_index"`
+	StartDate          types.String  `tfsdk:"start_date"`
+	StartDateLocal     types.String  `tfsdk:"start_date_local"`
+	Distance           types.Float64 `tfsdk:"distance"`
+	ElapsedTime        types.Int64   `tfsdk:"elapsed_time"`
+	MovingTime         types.Int64   `tfsdk:"moving_time"`
+	TotalElevationGain types.Float64 `tfsdk:"total_elevation_gain"`
+	AverageSpeed       types.Float64 `tfsdk:"average_speed"`
+	MaxSpeed           types.Float64 `tfsdk:"max_speed"`
+	AverageCadence     types.Float64 `tfsdk:"average_cadence"`
+	AverageHeartrate   types.Float64 `tfsdk:"average_heartrate"`
+	MaxHeartrate       types.Float64 `tfsdk:"max_heartrate"`
+	AverageWatts       types.Float64 `tfsdk:"average_watts"`
+	StartIndex         types.Int64   `tfsdk:"start_index"`
+	EndIndex           types.Int64   `tfsdk:"end_index"`
+}
+
+// segmentEffortModel maps segment and best effort schema data.
+type segmentEffortModel struct {
+	ID               types.Int64   `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	StartDate        types.String  `tfsdk:"start_date"`
+	StartDateLocal   types.String  `tfsdk:"start_date_local"`
+	Distance         types.Float64 `tfsdk:"distance"`
+	ElapsedTime      types.Int64   `tfsdk:"elapsed_time"`
+	MovingTime       types.Int64   `tfsdk:"moving_time"`
+	StartIndex       types.Int64   `tfsdk:"start_index"`
+	EndIndex         types.Int64   `tfsdk:"end_index"`
+	AverageHeartrate types.Float64 `tfsdk:"average_heartrate"`
+	MaxHeartrate     types.Float64 `tfsdk:"max_heartrate"`
+	AverageWatts     types.Float64 `tfsdk:"average_watts"`
+	PRRank           types.Int64   `tfsdk:"pr_rank"`
+	KOMRank          types.Int64   `tfsdk:"kom_rank"`
+	SegmentID        types.Int64   `tfsdk:"segment_id"`
+	SegmentName      types.String  `tfsdk:"segment_name"`
+}
+
+// Metadata returns the data source type name.
+func (d *activityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity"
+}
+
+// Schema defines the schema for the data source.
+func (d *activityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	splitsAttribute := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			Description: description,
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"split": schema.Int64Attribute{
+						Description: "Number of the split, starting at 1.",
+						Computed:    true,
+					},
+					"distance": schema.Float64Attribute{
+						Description: "Distance of the split, in meters.",
+						Computed:    true,
+					},
+					"elapsed_time": schema.Int64Attribute{
+						Description: "Elapsed time, in seconds.",
+						Computed:    true,
+					},
+					"moving_time": schema.Int64Attribute{
+						Description: "Moving time, in seconds.",
+						Computed:    true,
+					},
+					"elevation_difference": schema.Float64Attribute{
+						Description: "Elevation difference, in meters.",
+						Computed:    true,
+					},
+					"average_speed": schema.Float64Attribute{
+						Description: "Average speed, in meters per second.",
+						Computed:    true,
+					},
+					"pace_zone": schema.Int64Attribute{
+						Description: "Pace zone of the split.",
+						Computed:    true,
+					},
+				},
+			},
+		}
+	}
+
+	effortsAttribute := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			Description: description,
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Description: "Effort ID.",
+						Computed:    true,
+					},
+					"name": schema.StringAttribute{
+						Description: "Name of the effort.",
+						Computed:    true,
+					},
+					"start_date": schema.StringAttribute{
+						Description: "Date and time the effort started, in UTC.",
+						Computed:    true,
+					},
+					"start_date_local": schema.StringAttribute{
+						Description: "Local date and time the effort started.",
+						Computed:    true,
+					},
+					"distance": schema.Float64Attribute{
+						Description: "Distance of the effort, in meters.",
+						Computed:    true,
+					},
+					"elapsed_time": schema.Int64Attribute{
+						Description: "Elapsed time, in seconds.",
+						Computed:    true,
+					},
+					"moving_time": schema.Int64Attribute{
+						Description: "Moving time, in seconds.",
+						Computed:    true,
+					},
+					"start_index": schema.Int64Attribute{
+						Description: "Index of the first data point of the effort in the activity streams.",
+						Computed:    true,
+					},
+					"end_index": schema.Int64Attribute{
+						Description: "Index of the last data point of the effort in the activity streams.",
+						Computed:    true,
+					},
+					"average_heartrate": schema.Float64Attribute{
+						Description: "Average heart rate, in beats per minute.",
+						Computed:    true,
+					},
+					"max_heartrate": schema.Float64Attribute{
+						Description: "Maximum heart rate, in beats per minute.",
+						Computed:    true,
+					},
+					"average_watts": schema.Float64Attribute{
+						Description: "Average power, in watts.",
+						Computed:    true,
+					},
+					"pr_rank": schema.Int64Attribute{
+						Description: "Rank of the effort among the athlete's efforts, if in the top 3.",
+						Computed:    true,
+					},
+					"kom_rank": schema.Int64Attribute{
+						Description: "Rank of the effort on the segment leaderboard, if in the top 10.",
+						Computed:    true,
+					},
+					"segment_id": schema.Int64Attribute{
+						Description: "ID of the segment, null for best efforts.",
+						Computed:    true,
+					},
+					"segment_name": schema.StringAttribute{
+						Description: "Name of the segment, null for best efforts.",
+						Computed:    true,
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches an activity with its splits, laps and efforts. " +
+			"Requires the provider refresh_token with the activity:read scope, or activity:read_all for private activities.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+			},
+			"id": schema.Int64Attribute{
+				Description: "Activity ID.",
+				Required:    true,
+			},
+			"include_all_efforts": schema.BoolAttribute{
+				Description: "Whether to include all segment efforts rather than only the most important ones.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the activity.",
+				Computed:    true,
+			},
+			"sport_type": schema.StringAttribute{
+				Description: "Sport type of the activity.",
+				Computed:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "Date and time the activity started, in UTC.",
+				Computed:    true,
+			},
+			"start_date_local": schema.StringAttribute{
+				Description: "Local date and time the activity started.",
+				Computed:    true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "Time zone of the activity.",
+				Computed:    true,
+			},
+			"distance": schema.Float64Attribute{
+				Description: "Distance, in meters.",
+				Computed:    true,
+			},
+			"moving_time": schema.Int64Attribute{
+				Description: "Moving time, in seconds.",
+				Computed:    true,
+			},
+			"elapsed_time": schema.Int64Attribute{
+				Description: "Elapsed time, in seconds.",
+				Computed:    true,
+			},
+			"total_elevation_gain": schema.Float64Attribute{
+				Description: "Elevation gain, in meters.",
+				Computed:    true,
+			},
+			"average_speed": schema.Float64Attribute{
+				Description: "Average speed, in meters per second.",
+				Computed:    true,
+			},
+			"max_speed": schema.Float64Attribute{
+				Description: "Maximum speed, in meters per second.",
+				Computed:    true,
+			},
+			"trainer": schema.BoolAttribute{
+				Description: "Whether the activity was done on a trainer.",
+				Computed:    true,
+			},
+			"commute": schema.BoolAttribute{
+				Description: "Whether the activity is a commute.",
+				Computed:    true,
+			},
+			"manual": schema.BoolAttribute{
+				Description: "Whether the activity was created manually.",
+				Computed:    true,
+			},
+			"private": schema.BoolAttribute{
+				Description: "Whether the activity is private.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the activity.",
+				Computed:    true,
+			},
+			"calories": schema.Float64Attribute{
+				Description: "Kilocalories burned during the activity.",
+				Computed:    true,
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Name of the device that recorded the activity.",
+				Computed:    true,
+			},
+			"gear_id": schema.StringAttribute{
+				Description: "ID of the gear used.",
+				Computed:    true,
+			},
+			"gear": schema.SingleNestedAttribute{
+				Description: "Gear used for the activity.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "Gear ID.",
+						Computed:    true,
+					},
+					"name": schema.StringAttribute{
+						Description: "Name of the gear.",
+						Computed:    true,
+					},
+					"primary": schema.BoolAttribute{
+						Description: "Whether this is the athlete's default gear.",
+						Computed:    true,
+					},
+					"distance": schema.Float64Attribute{
+						Description: "Distance logged with the gear, in meters.",
+						Computed:    true,
+					},
+				},
+			},
+			"polyline": schema.StringAttribute{
+				Description: "Encoded polyline of the route.",
+				Computed:    true,
+			},
+			"summary_polyline": schema.StringAttribute{
+				Description: "Encoded polyline of the route, at reduced resolution.",
+				Computed:    true,
+			},
+			"splits_metric":   splitsAttribute("Splits per kilometer."),
+			"splits_standard": splitsAttribute("Splits per mile."),
+			"laps": schema.ListNestedAttribute{
+				Description: "Laps of the activity.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: activityLapAttributes(),
+				},
+			},
+			"segment_efforts": effortsAttribute("Efforts on segments during the activity."),
+			"best_efforts":    effortsAttribute("Best efforts over standard distances, for runs."),
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *activityDataSource) requiredScopes() []string {
+	return []string{"activity:read"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *activityDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *activityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config activityDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(config.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activity, err := client.getActivity(ctx, config.ID.ValueInt64(), config.IncludeAllEfforts.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Activity",
+			"Could not read activity ID "+strconv.FormatInt(config.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := activityDataSourceModel{
+		AthleteProfile:     config.AthleteProfile,
+		ID:                 types.Int64Value(activity.ID),
+		IncludeAllEfforts:  config.IncludeAllEfforts,
+		Name:               types.StringValue(activity.Name),
+		SportType:          types.StringValue(activity.SportType),
+		StartDate:          types.StringValue(activity.StartDate),
+		StartDateLocal:     types.StringValue(activity.StartDateLocal),
+		Timezone:           types.StringValue(activity.Timezone),
+		Distance:           types.Float64Value(activity.Distance),
+		MovingTime:         types.Int64Value(activity.MovingTime),
+		ElapsedTime:        types.Int64Value(activity.ElapsedTime),
+		TotalElevationGain: types.Float64Value(activity.TotalElevationGain),
+		AverageSpeed:       types.Float64Value(activity.AverageSpeed),
+		MaxSpeed:           types.Float64Value(activity.MaxSpeed),
+		Trainer:            types.BoolValue(activity.Trainer),
+		Commute:            types.BoolValue(activity.Commute),
+		Manual:             types.BoolValue(activity.Manual),
+		Private:            types.BoolValue(activity.Private),
+		Description:        types.StringPointerValue(activity.Description),
+		Calories:           types.Float64Value(activity.Calories),
+		DeviceName:         types.StringPointerValue(activity.DeviceName),
+		GearID:             types.StringPointerValue(activity.GearID),
+		Polyline:           types.StringPointerValue(activity.Map.Polyline),
+		SummaryPolyline:    types.StringPointerValue(activity.Map.SummaryPolyline),
+		SplitsMetric:       newActivitySplitModels(activity.SplitsMetric),
+		SplitsStandard:     newActivitySplitModels(activity.SplitsStandard),
+		Laps:               newActivityLapModels(activity.Laps),
+		SegmentEfforts:     newSegmentEffortModels(activity.SegmentEfforts),
+		BestEfforts:        newSegmentEffortModels(activity.BestEfforts),
+	}
+
+	if activity.Gear != nil {
+		state.Gear = &gearSummaryModel{
+			ID:       types.StringValue(activity.Gear.ID),
+			Name:     types.StringValue(activity.Gear.Name),
+			Primary:  types.BoolValue(activity.Gear.Primary),
+			Distance: types.Float64Value(activity.Gear.Distance),
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *activityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
+
+// activityLapAttributes returns the schema attributes of an activity lap.
+func activityLapAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Lap ID.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the lap.",
+			Computed:    true,
+		},
+		"lap_index": schema.Int64Attribute{
+			Description: "Number of the lap, starting at 1.",
+			Computed:    true,
+		},
+		"start_date": schema.StringAttribute{
+			Description: "Date and time the lap started, in UTC.",
+			Computed:    true,
+		},
+		"start_date_local": schema.StringAttribute{
+			Description: "Local date and time the lap started.",
+			Computed:    true,
+		},
+		"distance": schema.Float64Attribute{
+			Description: "Distance of the lap, in meters.",
+			Computed:    true,
+		},
+		"elapsed_time": schema.Int64Attribute{
+			Description: "Elapsed time, in seconds.",
+			Computed:    true,
+		},
+		"moving_time": schema.Int64Attribute{
+			Description: "Moving time, in seconds.",
+			Computed:    true,
+		},
+		"total_elevation_gain": schema.Float64Attribute{
+			Description: "Elevation gain, in meters.",
+			Computed:    true,
+		},
+		"average_speed": schema.Float64Attribute{
+			Description: "Average speed, in meters per second.",
+			Computed:    true,
+		},
+		"max_speed": schema.Float64Attribute{
+			Description: "Maximum speed, in meters per second.",
+			Computed:    true,
+		},
+		"average_cadence": schema.Float64Attribute{
+			Description: "Average cadence, in revolutions or steps per minute.",
+			Computed:    true,
+		},
+		"average_heartrate": schema.Float64Attribute{
+			Description: "Average heart rate, in beats per minute.",
+			Computed:    true,
+		},
+		"max_heartrate": schema.Float64Attribute{
+			Description: "Maximum heart rate, in beats per minute.",
+			Computed:    true,
+		},
+		"average_watts": schema.Float64Attribute{
+			Description: "Average power, in watts.",
+			Computed:    true,
+		},
+		"start_index": schema.Int64Attribute{
+			Description: "Index of the first data point of the lap in the activity streams.",
+			Computed:    true,
+		},
+		"end_index": schema.Int64Attribute{
+			Description: "Index of the last data point of the lap in the activity streams.",
+			Computed:    true,
+		},
+	}
+}
+
+// newActivitySplitModels maps splits to their schema data.
+func newActivitySplitModels(splits []split) []activitySplitModel {
+	models := []activitySplitModel{}
+	for _, s := range splits {
+		models = append(models, activitySplitModel{
+			Split:               types.Int64Value(s.Split),
+			Distance:            types.Float64Value(s.Distance),
+			ElapsedTime:         types.Int64Value(s.ElapsedTime),
+			MovingTime:          types.Int64Value(s.MovingTime),
+			ElevationDifference: types.Float64Value(s.ElevationDifference),
+			AverageSpeed:        types.Float64Value(s.AverageSpeed),
+			PaceZone:            types.Int64Value(s.PaceZone),
+		})
+	}
+
+	return models
+}
+
+// newActivityLapModels maps laps to their schema data.
+func newActivityLapModels(laps []lap) []activityLapModel {
+	models := []activityLapModel{}
+	for _, l := range laps {
+		models = append(models, activityLapModel{
+			ID:                 types.Int64Value(l.ID),
+			Name:               types.StringValue(l.Name),
+			LapIndex:           types.Int64Value(l.LapIndex),
+			StartDate:          types.StringValue(l.StartDate),
+			StartDateLocal:     types.StringValue(l.StartDateLocal),
+			Distance:           types.Float64Value(l.Distance),
+			ElapsedTime:        types.Int64Value(l.ElapsedTime),
+			MovingTime:         types.Int64Value(l.MovingTime),
+			TotalElevationGain: types.Float64Value(l.TotalElevationGain),
+			AverageSpeed:       types.Float64Value(l.AverageSpeed),
+			MaxSpeed:           types.Float64Value(l.MaxSpeed),
+			AverageCadence:     types.Float64PointerValue(l.AverageCadence),
+			AverageHeartrate:   types.Float64PointerValue(l.AverageHeartrate),
+			MaxHeartrate:       types.Float64PointerValue(l.MaxHeartrate),
+			AverageWatts:       types.Float64PointerValue(l.AverageWatts),
+			StartIndex:         types.Int64Value(l.StartIndex),
+			EndIndex:           types.Int64Value(l.EndIndex),
+		})
+	}
+
+	return models
+}
+
+// newSegmentEffortModels maps segment or best efforts to their schema data.
+func newSegmentEffortModels(efforts []detailedSegmentEffort) []segmentEffortModel {
+	models := []segmentEffortModel{}
+	for _, e := range efforts {
+		model := segmentEffortModel{
+			ID:               types.Int64Value(e.ID),
+			Name:             types.StringValue(e.Name),
+			StartDate:        types.StringValue(e.StartDate),
+			StartDateLocal:   types.StringValue(e.StartDateLocal),
+			Distance:         types.Float64Value(e.Distance),
+			ElapsedTime:      types.Int64Value(e.ElapsedTime),
+			MovingTime:       types.Int64Value(e.MovingTime),
+			StartIndex:       types.Int64Value(e.StartIndex),
+			EndIndex:         types.Int64Value(e.EndIndex),
+			AverageHeartrate: types.Float64PointerValue(e.AverageHeartrate),
+			MaxHeartrate:     types.Float64PointerValue(e.MaxHeartrate),
+			AverageWatts:     types.Float64PointerValue(e.AverageWatts),
+			PRRank:           types.Int64PointerValue(e.PRRank),
+			KOMRank:          types.Int64PointerValue(e.KOMRank),
+			SegmentID:        types.Int64Null(),
+			SegmentName:      types.StringNull(),
+		}
+
+		if e.Segment != nil {
+			model.SegmentID = types.Int64Value(e.Segment.ID)
+			model.SegmentName = types.StringValue(e.Segment.Name)
+		}
+
+		models = append(models, model)
+	}
+
+	return models
+}
@@ -0,0 +1,151 @@
+package strava
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewActivitySplitModels(t *testing.T) {
+	var splits []split
+	if err := json.Unmarshal([]byte(`[
+		{"split": 1, "distance": 1000.2, "elapsed_time": 300, "moving_time": 290, "elevation_difference": -1.5, "average_speed": 3.45, "pace_zone": 2}
+	]`), &splits); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []activitySplitModel{
+		{
+			Split:               types.Int64Value(1),
+			Distance:            types.Float64Value(1000.2),
+			ElapsedTime:         types.Int64Value(300),
+			MovingTime:          types.Int64Value(290),
+			ElevationDifference: types.Float64Value(-1.5),
+			AverageSpeed:        types.Float64Value(3.45),
+			PaceZone:            types.Int64Value(2),
+		},
+	}
+
+	if got := newActivitySplitModels(splits); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+
+	if got := newActivitySplitModels(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list without splits, got %v", got)
+	}
+}
+
+func TestNewActivityLapModels(t *testing.T) {
+	var laps []lap
+	if err := json.Unmarshal([]byte(`[
+		{
+			"id": 11, "name": "Lap 1", "lap_index": 1,
+			"start_date": "2023-05-01T10:00:00Z", "start_date_local": "2023-05-01T12:00:00Z",
+			"distance": 1000, "elapsed_time": 300, "moving_time": 290, "total_elevation_gain": 4.2,
+			"average_speed": 3.4, "max_speed": 4.1, "average_cadence": 85.5, "average_heartrate": 150.1, "max_heartrate": 171,
+			"start_index": 0, "end_index": 299
+		},
+		{"id": 12, "name": "Lap 2", "lap_index": 2, "start_index": 300, "end_index": 599}
+	]`), &laps); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := newActivityLapModels(laps)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 laps, got %d", len(got))
+	}
+
+	expected := activityLapModel{
+		ID:                 types.Int64Value(11),
+		Name:               types.StringValue("Lap 1"),
+		LapIndex:           types.Int64Value(1),
+		StartDate:          types.StringValue("2023-05-01T10:00:00Z"),
+		StartDateLocal:     types.StringValue("2023-05-01T12:00:00Z"),
+		Distance:           types.Float64Value(1000),
+		ElapsedTime:        types.Int64Value(300),
+		MovingTime:         types.Int64Value(290),
+		TotalElevationGain: types.Float64Value(4.2),
+		AverageSpeed:       types.Float64Value(3.4),
+		MaxSpeed:           types.Float64Value(4.1),
+		AverageCadence:     types.Float64Value(85.5),
+		AverageHeartrate:   types.Float64Value(150.1),
+		MaxHeartrate:       types.Float64Value(171),
+		AverageWatts:       types.Float64Null(),
+		StartIndex:         types.Int64Value(0),
+		EndIndex:           types.Int64Value(299),
+	}
+
+	if !reflect.DeepEqual(got[0], expected) {
+		t.Errorf("expected %+v, got %+v", expected, got[0])
+	}
+
+	// Sensor data is null for laps recorded without the sensors
+	if !got[1].AverageCadence.IsNull() || !got[1].AverageHeartrate.IsNull() || !got[1].MaxHeartrate.IsNull() {
+		t.Errorf("expected null sensor data, got %+v", got[1])
+	}
+
+	if got[1].StartIndex.ValueInt64() != 300 || got[1].EndIndex.ValueInt64() != 599 {
+		t.Errorf("expected indexes 300 to 599, got %+v", got[1])
+	}
+}
+
+func TestNewSegmentEffortModels(t *testing.T) {
+	var efforts []detailedSegmentEffort
+	if err := json.Unmarshal([]byte(`[
+		{
+			"id": 21, "name": "Hill Climb",
+			"start_date": "2023-05-01T10:05:00Z", "start_date_local": "2023-05-01T12:05:00Z",
+			"distance": 800, "elapsed_time": 240, "moving_time": 238, "start_index": 10, "end_index": 250,
+			"average_heartrate": 160.5, "max_heartrate": 175, "average_watts": 250.2, "pr_rank": 1, "kom_rank": null,
+			"segment": {"id": 31, "name": "Hill Climb Segment"}
+		},
+		{"id": 22, "name": "5k", "distance": 5000, "elapsed_time": 1500, "moving_time": 1500, "pr_rank": null}
+	]`), &efforts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []segmentEffortModel{
+		{
+			ID:               types.Int64Value(21),
+			Name:             types.StringValue("Hill Climb"),
+			StartDate:        types.StringValue("2023-05-01T10:05:00Z"),
+			StartDateLocal:   types.StringValue("2023-05-01T12:05:00Z"),
+			Distance:         types.Float64Value(800),
+			ElapsedTime:      types.Int64Value(240),
+			MovingTime:       types.Int64Value(238),
+			StartIndex:       types.Int64Value(10),
+			EndIndex:         types.Int64Value(250),
+			AverageHeartrate: types.Float64Value(160.5),
+			MaxHeartrate:     types.Float64Value(175),
+			AverageWatts:     types.Float64Value(250.2),
+			PRRank:           types.Int64Value(1),
+			KOMRank:          types.Int64Null(),
+			SegmentID:        types.Int64Value(31),
+			SegmentName:      types.StringValue("Hill Climb Segment"),
+		},
+		{
+			ID:               types.Int64Value(22),
+			Name:             types.StringValue("5k"),
+			StartDate:        types.StringValue(""),
+			StartDateLocal:   types.StringValue(""),
+			Distance:         types.Float64Value(5000),
+			ElapsedTime:      types.Int64Value(1500),
+			MovingTime:       types.Int64Value(1500),
+			StartIndex:       types.Int64Value(0),
+			EndIndex:         types.Int64Value(0),
+			AverageHeartrate: types.Float64Null(),
+			MaxHeartrate:     types.Float64Null(),
+			AverageWatts:     types.Float64Null(),
+			PRRank:           types.Int64Null(),
+			KOMRank:          types.Int64Null(),
+			SegmentID:        types.Int64Null(),
+			SegmentName:      types.StringNull(),
+		},
+	}
+
+	if got := newSegmentEffortModels(efforts); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
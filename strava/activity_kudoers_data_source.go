@@ -0,0 +1,167 @@
+package strava
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &activityKudoersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &activityKudoersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &activityKudoersDataSource{}
+	_ withRequiredScopes                      = &activityKudoersDataSource{}
+)
+
+// NewActivityKudoersDataSource is a helper function to simplify the provider implementation.
+func NewActivityKudoersDataSource() datasource.DataSource {
+	return &activityKudoersDataSource{}
+}
+
+// activityKudoersDataSource is the data source implementation.
+type activityKudoersDataSource struct {
+	athletes athleteClients
+}
+
+// activityKudoersDataSourceModel maps the data source schema data.
+type activityKudoersDataSourceModel struct {
+	AthleteProfile types.String          `tfsdk:"athlete_profile"`
+	ID             types.String          `tfsdk:"id"`
+	ActivityID     types.Int64           `tfsdk:"activity_id"`
+	MaxItems       types.Int64           `tfsdk:"max_items"`
+	Kudoers        []activityKudoerModel `tfsdk:"kudoers"`
+}
+
+// activityKudoerModel maps activity kudoer schema data.
+type activityKudoerModel struct {
+	Firstname types.String `tfsdk:"firstname"`
+	Lastname  types.String `tfsdk:"lastname"`
+}
+
+// Metadata returns the data source type name.
+func (d *activityKudoersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity_kudoers"
+}
+
+// Schema defines the schema for the data source.
+func (d *activityKudoersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the athletes who gave kudos to an activity, paging through all of them. " +
+			"Unlike comments, which are paged with a cursor, Strava only pages kudoers by page number and page size, " +
+			"so kudos given while paging may shift kudoers between pages and cause duplicates or omissions. " +
+			"Strava only returns the names of kudoers. " +
+			"Requires the provider refresh_token with the activity:read scope, or activity:read_all for private activities.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"activity_id": schema.Int64Attribute{
+				Description: "Activity ID.",
+				Required:    true,
+			},
+			"max_items": schema.Int64Attribute{
+				Description: "Maximum number of kudoers to return. Defaults to all kudoers. " +
+					"Pages of " + strconv.Itoa(kudoersPageSize) + " kudoers are requested until this many are collected.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"kudoers": schema.ListNestedAttribute{
+				Description: "Athletes who gave kudos to the activity.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"firstname": schema.StringAttribute{
+							Description: "First name of the athlete.",
+							Computed:    true,
+						},
+						"lastname": schema.StringAttribute{
+							Description: "Initial of the last name of the athlete.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *activityKudoersDataSource) requiredScopes() []string {
+	return []string{"activity:read"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *activityKudoersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *activityKudoersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state activityKudoersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(state.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kudoers, err := client.listActivityKudoers(ctx, state.ActivityID.ValueInt64(), int(state.MaxItems.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Activity Kudoers",
+			"Could not read kudoers of activity ID "+strconv.FormatInt(state.ActivityID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue("placeholder")
+	state.Kudoers = []activityKudoerModel{}
+	for _, kudoer := range kudoers {
+		state.Kudoers = append(state.Kudoers, activityKudoerModel{
+			Firstname: types.StringValue(kudoer.Firstname),
+			Lastname:  types.StringValue(kudoer.Lastname),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *activityKudoersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
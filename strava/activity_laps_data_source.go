@@ -0,0 +1,132 @@
+package strava
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &activityLapsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &activityLapsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &activityLapsDataSource{}
+	_ withRequiredScopes                      = &activityLapsDataSource{}
+)
+
+// NewActivityLapsDataSource is a helper function to simplify the provider implementation.
+func NewActivityLapsDataSource() datasource.DataSource {
+	return &activityLapsDataSource{}
+}
+
+// activityLapsDataSource is the data source implementation.
+type activityLapsDataSource struct {
+	athletes athleteClients
+}
+
+// activityLapsDataSourceModel maps the data source schema data.
+type activityLapsDataSourceModel struct {
+	AthleteProfile types.String       `tfsdk:"athlete_profile"`
+	ID             types.String       `tfsdk:"id"`
+	ActivityID     types.Int64        `tfsdk:"activity_id"`
+	Laps           []activityLapModel `tfsdk:"laps"`
+}
+
+// Metadata returns the data source type name.
+func (d *activityLapsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity_laps"
+}
+
+// Schema defines the schema for the data source.
+func (d *activityLapsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the laps of an activity. " +
+			"Requires the provider refresh_token with the activity:read scope, or activity:read_all for private activities.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"activity_id": schema.Int64Attribute{
+				Description: "Activity ID.",
+				Required:    true,
+			},
+			"laps": schema.ListNestedAttribute{
+				Description: "Laps of the activity.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: activityLapAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *activityLapsDataSource) requiredScopes() []string {
+	return []string{"activity:read"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *activityLapsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *activityLapsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state activityLapsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(state.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	laps, err := client.getActivityLaps(ctx, state.ActivityID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Activity Laps",
+			"Could not read laps of activity ID "+strconv.FormatInt(state.ActivityID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue("placeholder")
+	state.Laps = newActivityLapModels(laps)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *activityLapsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
@@ -0,0 +1,524 @@
+package strava
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &activityResource{}
+	_ resource.ResourceWithConfigure   = &activityResource{}
+	_ resource.ResourceWithImportState = &activityResource{}
+	_ resource.ResourceWithModifyPlan  = &activityResource{}
+	_ withRequiredScopes               = &activityResource{}
+)
+
+// What to do with an activity when the resource is destroyed, as Strava has
+// no endpoint to delete activities.
+const (
+	destroyBehaviorForget = "forget"
+	destroyBehaviorHide   = "hide"
+)
+
+// hiddenActivityNamePrefix is prepended to the name of activities hidden on
+// destroy.
+const hiddenActivityNamePrefix = "[Deleted] "
+
+// NewActivityResource is a helper function to simplify the provider implementation.
+func NewActivityResource() resource.Resource {
+	return &activityResource{}
+}
+
+// activityResource is the resource implementation.
+type activityResource struct {
+	athletes athleteClients
+}
+
+// activityResourceModel maps the resource schema data.
+type activityResourceModel struct {
+	AthleteProfile  types.String  `tfsdk:"athlete_profile"`
+	ID              types.Int64   `tfsdk:"id"`
+	Name            types.String  `tfsdk:"name"`
+	SportType       types.String  `tfsdk:"sport_type"`
+	StartDateLocal  types.String  `tfsdk:"start_date_local"`
+	ElapsedTime     types.Int64   `tfsdk:"elapsed_time"`
+	Distance        types.Float64 `tfsdk:"distance"`
+	Description     types.String  `tfsdk:"description"`
+	Trainer         types.Bool    `tfsdk:"trainer"`
+	Commute         types.Bool    `tfsdk:"commute"`
+	HideFromHome    types.Bool    `tfsdk:"hide_from_home"`
+	GearID          types.String  `tfsdk:"gear_id"`
+	DestroyBehavior types.String  `tfsdk:"destroy_behavior"`
+}
+
+// Metadata returns the resource type name.
+func (r *activityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity"
+}
+
+// Schema defines the schema for the resource.
+func (r *activityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a manual activity of the athlete the provider acts on behalf of. " +
+			"Requires the provider refresh_token with the activity:write and activity:read scopes. " +
+			"Strava cannot delete activities, so destroying the resource forgets or hides the activity, see destroy_behavior.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description: "Activity ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the activity.",
+				Required:    true,
+			},
+			"sport_type": schema.StringAttribute{
+				Description: "Sport type of the activity, e.g. \"Run\" or \"WeightTraining\". Strava rejects unknown sport types when the activity is saved.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"start_date_local": schema.StringAttribute{
+				Description: "Local date and time the activity started, e.g. \"2023-05-01T18:30:00Z\". Any time zone offset is ignored. " +
+					"Changing it creates a new activity.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"elapsed_time": schema.Int64Attribute{
+				Description: "Duration of the activity, in seconds. Changing it creates a new activity.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"distance": schema.Float64Attribute{
+				Description: "Distance of the activity, in meters. Changing it creates a new activity.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the activity.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"trainer": schema.BoolAttribute{
+				Description: "Whether the activity was done on a trainer. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"commute": schema.BoolAttribute{
+				Description: "Whether the activity is a commute. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"hide_from_home": schema.BoolAttribute{
+				Description: "Whether the activity is muted, i.e. hidden from the home feed of followers. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"gear_id": schema.StringAttribute{
+				Description: "ID of the gear used, or \"none\" for no gear. Strava assigns the default gear of the sport type when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destroy_behavior": schema.StringAttribute{
+				Description: "What to do with the activity when the resource is destroyed, as Strava cannot delete activities. " +
+					"One of \"forget\" (default) to only remove it from state, " +
+					"or \"hide\" to prefix its name with \"" + hiddenActivityNamePrefix + "\" and hide it from the home feed. " +
+					"This also applies to the old activity when a change creates a new one.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(destroyBehaviorForget),
+				Validators: []validator.String{
+					stringvalidator.OneOf(destroyBehaviorForget, destroyBehaviorHide),
+				},
+			},
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the resource requires.
+func (r *activityResource) requiredScopes() []string {
+	return []string{"activity:write", "activity:read"}
+}
+
+// ModifyPlan reports missing OAuth scopes before any change is made, and
+// warns when replacing the activity leaves the old one on the feed.
+func (r *activityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var profile types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.athletes.validateScopes(profile, r.requiredScopes()...)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state activityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete uses the destroy_behavior in state, not the planned one
+	if replaced := plan.replacedAttributes(state); len(replaced) > 0 && state.DestroyBehavior.ValueString() != destroyBehaviorHide {
+		resp.Diagnostics.AddWarning(
+			"Strava Activity Will Be Left Behind",
+			"Changing "+strings.Join(replaced, ", ")+" creates a new activity, but Strava cannot delete activities "+
+				"and destroy_behavior is \""+destroyBehaviorForget+"\", so activity ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+
+				" will stay visible on the athlete's feed. To hide it instead, apply destroy_behavior = \""+destroyBehaviorHide+"\" before this change.",
+		)
+	}
+}
+
+// Create a new resource
+func (r *activityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan activityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.athletes.client(plan.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	form := url.Values{}
+	form.Set("name", plan.Name.ValueString())
+	form.Set("sport_type", plan.SportType.ValueString())
+	form.Set("start_date_local", plan.StartDateLocal.ValueString())
+	form.Set("elapsed_time", strconv.FormatInt(plan.ElapsedTime.ValueInt64(), 10))
+	if !plan.Distance.IsUnknown() && !plan.Distance.IsNull() {
+		form.Set("distance", strconv.FormatFloat(plan.Distance.ValueFloat64(), 'f', -1, 64))
+	}
+	if !plan.Description.IsNull() {
+		form.Set("description", plan.Description.ValueString())
+	}
+	if plan.Trainer.ValueBool() {
+		form.Set("trainer", "1")
+	}
+	if plan.Commute.ValueBool() {
+		form.Set("commute", "1")
+	}
+
+	// Create new activity
+	activity, err := client.createActivity(ctx, form)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Strava Activity",
+			"Could not create activity, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Settings that can only be updated
+	if plan.HideFromHome.ValueBool() || !plan.GearID.IsUnknown() {
+		updated, err := client.updateActivity(ctx, activity.ID, plan.updatableActivity())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Strava Activity",
+				"Created activity ID "+strconv.FormatInt(activity.ID, 10)+", but could not update its settings: "+err.Error(),
+			)
+			return
+		}
+
+		activity = updated
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.setActivity(activity)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *activityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state activityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.athletes.client(state.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed activity from Strava
+	activity, err := client.getActivity(ctx, state.ID.ValueInt64(), false)
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, "Activity no longer exists, removing from state", map[string]any{"id": state.ID.ValueInt64()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Strava Activity",
+			"Could not read Strava activity ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.setActivity(activity)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *activityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan activityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.athletes.client(plan.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing activity
+	activity, err := client.updateActivity(ctx, plan.ID.ValueInt64(), plan.updatableActivity())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Strava Activity",
+			"Could not update activity ID "+strconv.FormatInt(plan.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setActivity(activity)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete forgets the activity, or hides it first if so configured, since
+// Strava cannot delete activities.
+func (r *activityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state activityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DestroyBehavior.ValueString() != destroyBehaviorHide {
+		return
+	}
+
+	client, diags := r.athletes.client(state.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hidden := state.updatableActivity()
+	if !strings.HasPrefix(hidden.Name, hiddenActivityNamePrefix) {
+		hidden.Name = hiddenActivityNamePrefix + hidden.Name
+	}
+	hidden.HideFromHome = true
+
+	_, err := client.updateActivity(ctx, state.ID.ValueInt64(), hidden)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Hiding Strava Activity",
+			"Could not hide activity ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *activityResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
+
+func (r *activityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idPart, profile, hasProfile := strings.Cut(req.ID, ",")
+
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing item",
+			"Could not import item, unexpected error (ID should be the activity ID, optionally followed by a comma and the athlete profile): "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destroy_behavior"), destroyBehaviorForget)...)
+	if hasProfile {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("athlete_profile"), profile)...)
+	}
+}
+
+// updatableActivity returns the settings of the model that can be updated.
+func (m *activityResourceModel) updatableActivity() updatableActivity {
+	update := updatableActivity{
+		Name:         m.Name.ValueString(),
+		SportType:    m.SportType.ValueString(),
+		Description:  m.Description.ValueString(),
+		Trainer:      m.Trainer.ValueBool(),
+		Commute:      m.Commute.ValueBool(),
+		HideFromHome: m.HideFromHome.ValueBool(),
+	}
+
+	if !m.GearID.IsUnknown() {
+		update.GearID = m.GearID.ValueString()
+	}
+
+	return update
+}
+
+// replacedAttributes returns the attributes whose change from state replaces
+// the activity.
+func (m *activityResourceModel) replacedAttributes(state activityResourceModel) []string {
+	var replaced []string
+	if !m.AthleteProfile.Equal(state.AthleteProfile) {
+		replaced = append(replaced, "athlete_profile")
+	}
+	if !m.StartDateLocal.Equal(state.StartDateLocal) {
+		replaced = append(replaced, "start_date_local")
+	}
+	if !m.ElapsedTime.Equal(state.ElapsedTime) {
+		replaced = append(replaced, "elapsed_time")
+	}
+	if !m.Distance.Equal(state.Distance) {
+		replaced = append(replaced, "distance")
+	}
+
+	return replaced
+}
+
+// setActivity maps an activity response to the model.
+func (m *activityResourceModel) setActivity(activity *detailedActivity) {
+	m.ID = types.Int64Value(activity.ID)
+	m.Name = types.StringValue(activity.Name)
+	m.SportType = types.StringValue(activity.SportType)
+	m.ElapsedTime = types.Int64Value(activity.ElapsedTime)
+	m.Distance = types.Float64Value(activity.Distance)
+	m.Trainer = types.BoolValue(activity.Trainer)
+	m.Commute = types.BoolValue(activity.Commute)
+	m.HideFromHome = types.BoolValue(activity.HideFromHome)
+	if activity.GearID != nil || m.GearID.ValueString() != "none" {
+		m.GearID = types.StringPointerValue(activity.GearID)
+	}
+
+	if m.StartDateLocal.IsNull() || !sameLocalTime(m.StartDateLocal.ValueString(), activity.StartDateLocal) {
+		m.StartDateLocal = types.StringValue(activity.StartDateLocal)
+	}
+
+	// Strava returns an empty description for activities without one
+	if activity.Description == nil || *activity.Description == "" {
+		m.Description = types.StringNull()
+	} else {
+		m.Description = types.StringValue(*activity.Description)
+	}
+}
+
+// sameLocalTime reports whether two date and time strings denote the same
+// local date and time. Strava ignores time zone offsets in local times, and
+// returns them with a "Z" suffix whatever was sent.
+func sameLocalTime(a, b string) bool {
+	const localLayout = "2006-01-02T15:04:05"
+
+	parse := func(s string) (string, bool) {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t.Format(localLayout), true
+		}
+		if t, err := time.Parse(localLayout, s); err == nil {
+			return t.Format(localLayout), true
+		}
+		return "", false
+	}
+
+	localA, okA := parse(a)
+	localB, okB := parse(b)
+
+	return okA && okB && localA == localB
+}
@@ -0,0 +1,217 @@
+package strava
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSameLocalTime(t *testing.T) {
+	testCases := map[string]struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		"identical": {
+			a:        "2023-05-01T18:30:00Z",
+			b:        "2023-05-01T18:30:00Z",
+			expected: true,
+		},
+		"offset ignored": {
+			a:        "2023-05-01T18:30:00+02:00",
+			b:        "2023-05-01T18:30:00Z",
+			expected: true,
+		},
+		"without offset": {
+			a:        "2023-05-01T18:30:00",
+			b:        "2023-05-01T18:30:00Z",
+			expected: true,
+		},
+		"different": {
+			a: "2023-05-01T18:30:00Z",
+			b: "2023-05-01T16:30:00Z",
+		},
+		"invalid": {
+			a: "yesterday",
+			b: "2023-05-01T18:30:00Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := sameLocalTime(testCase.a, testCase.b); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestActivityResourceModel_SetActivity(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/activities/7", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": 7,
+			"name": "Lunch Run",
+			"sport_type": "Run",
+			"start_date_local": "2023-05-01T12:00:00Z",
+			"elapsed_time": 1800,
+			"distance": 5000.5,
+			"description": "",
+			"trainer": true,
+			"commute": false,
+			"hide_from_home": true,
+			"gear_id": null
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := &apiClient{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		tokens:     newTokenSource(nil, "", "access"),
+	}
+
+	activity, err := client.getActivity(context.Background(), 7, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		model    activityResourceModel
+		expected activityResourceModel
+	}{
+		"import": {
+			model: activityResourceModel{
+				StartDateLocal: types.StringNull(),
+				GearID:         types.StringUnknown(),
+			},
+			expected: activityResourceModel{
+				ID:             types.Int64Value(7),
+				Name:           types.StringValue("Lunch Run"),
+				SportType:      types.StringValue("Run"),
+				StartDateLocal: types.StringValue("2023-05-01T12:00:00Z"),
+				ElapsedTime:    types.Int64Value(1800),
+				Distance:       types.Float64Value(5000.5),
+				Description:    types.StringNull(),
+				Trainer:        types.BoolValue(true),
+				Commute:        types.BoolValue(false),
+				HideFromHome:   types.BoolValue(true),
+				GearID:         types.StringNull(),
+			},
+		},
+		"configured offset and no gear": {
+			model: activityResourceModel{
+				StartDateLocal: types.StringValue("2023-05-01T12:00:00+02:00"),
+				GearID:         types.StringValue("none"),
+			},
+			expected: activityResourceModel{
+				ID:             types.Int64Value(7),
+				Name:           types.StringValue("Lunch Run"),
+				SportType:      types.StringValue("Run"),
+				StartDateLocal: types.StringValue("2023-05-01T12:00:00+02:00"),
+				ElapsedTime:    types.Int64Value(1800),
+				Distance:       types.Float64Value(5000.5),
+				Description:    types.StringNull(),
+				Trainer:        types.BoolValue(true),
+				Commute:        types.BoolValue(false),
+				HideFromHome:   types.BoolValue(true),
+				GearID:         types.StringValue("none"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			model := testCase.model
+			model.setActivity(activity)
+
+			if !reflect.DeepEqual(model, testCase.expected) {
+				t.Errorf("expected %+v, got %+v", testCase.expected, model)
+			}
+		})
+	}
+}
+
+func TestActivityResourceModel_UpdatableActivity(t *testing.T) {
+	model := activityResourceModel{
+		Name:         types.StringValue("Lunch Run"),
+		SportType:    types.StringValue("Run"),
+		Description:  types.StringNull(),
+		Trainer:      types.BoolValue(false),
+		Commute:      types.BoolValue(true),
+		HideFromHome: types.BoolValue(true),
+		GearID:       types.StringUnknown(),
+	}
+
+	expected := updatableActivity{Name: "Lunch Run", SportType: "Run", Commute: true, HideFromHome: true}
+	if got := model.updatableActivity(); got != expected {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+
+	model.GearID = types.StringValue("none")
+	expected.GearID = "none"
+	if got := model.updatableActivity(); got != expected {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestActivityResourceModel_ReplacedAttributes(t *testing.T) {
+	state := activityResourceModel{
+		AthleteProfile: types.StringNull(),
+		StartDateLocal: types.StringValue("2023-05-01T12:00:00Z"),
+		ElapsedTime:    types.Int64Value(1800),
+		Distance:       types.Float64Value(5000),
+	}
+
+	testCases := map[string]struct {
+		change   func(plan *activityResourceModel)
+		expected []string
+	}{
+		"unchanged": {
+			change: func(*activityResourceModel) {},
+		},
+		"in place": {
+			change: func(plan *activityResourceModel) { plan.Name = types.StringValue("Renamed") },
+		},
+		"replaced": {
+			change: func(plan *activityResourceModel) {
+				plan.ElapsedTime = types.Int64Value(1900)
+				plan.Distance = types.Float64Unknown()
+			},
+			expected: []string{"elapsed_time", "distance"},
+		},
+		"other athlete": {
+			change:   func(plan *activityResourceModel) { plan.AthleteProfile = types.StringValue("coach") },
+			expected: []string{"athlete_profile"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := state
+			testCase.change(&plan)
+
+			if got := plan.replacedAttributes(state); !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &activityStreamsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &activityStreamsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &activityStreamsDataSource{}
+	_ withRequiredScopes                      = &activityStreamsDataSource{}
+)
+
+// activityStreamType describes the data of a type of activity stream.
+type activityStreamType struct {
+	description string
+	elementType attr.Type
+	// newData returns a pointer to a slice to decode the stream data into.
+	newData func() any
+}
+
+// activityStreamTypes are the types of activity streams, keyed by their name
+// in the Strava API.
+var activityStreamTypes = map[string]activityStreamType{
+	"time": {
+		description: "Time since the start of the activity, in seconds.",
+		elementType: types.Int64Type,
+		newData:     func() any { return &[]*int64{} },
+	},
+	"distance": {
+		description: "Distance since the start of the activity, in meters.",
+		elementType: types.Float64Type,
+		newData:     func() any { return &[]*float64{} },
+	},
+	"latlng": {
+		description: "Latitude and longitude pairs, in degrees.",
+		elementType: types.ListType{ElemType: types.Float64Type},
+		newData:     func() any { return &[][]float64{} },
+	},
+	"altitude": {
+		description: "Altitude, in meters.",
+		elementType: types.Float64Type,
+		newData:     func() any { return &[]*float64{} },
+	},
+	"velocity_smooth": {
+		description: "Smoothed speed, in meters per second.",
+		elementType: types.Float64Type,
+		newData:     func() any { return &[]*float64{} },
+	},
+	"heartrate": {
+		description: "Heart rate, in beats per minute.",
+		elementType: types.Int64Type,
+		newData:     func() any { return &[]*int64{} },
+	},
+	"cadence": {
+		description: "Cadence, in revolutions or steps per minute.",
+		elementType: types.Int64Type,
+		newData:     func() any { return &[]*int64{} },
+	},
+	"watts": {
+		description: "Power, in watts.",
+		elementType: types.Int64Type,
+		newData:     func() any { return &[]*int64{} },
+	},
+	"temp": {
+		description: "Temperature, in degrees Celsius.",
+		elementType: types.Int64Type,
+		newData:     func() any { return &[]*int64{} },
+	},
+	"moving": {
+		description: "Whether the athlete was moving.",
+		elementType: types.BoolType,
+		newData:     func() any { return &[]*bool{} },
+	},
+	"grade_smooth": {
+		description: "Smoothed grade, in percent.",
+		elementType: types.Float64Type,
+		newData:     func() any { return &[]*float64{} },
+	},
+}
+
+// NewActivityStreamsDataSource is a helper function to simplify the provider implementation.
+func NewActivityStreamsDataSource() datasource.DataSource {
+	return &activityStreamsDataSource{}
+}
+
+// activityStreamsDataSource is the data source implementation.
+type activityStreamsDataSource struct {
+	athletes athleteClients
+}
+
+// activityStreamsDataSourceModel maps the data source schema data.
+type activityStreamsDataSourceModel struct {
+	AthleteProfile types.String         `tfsdk:"athlete_profile"`
+	ID             types.String         `tfsdk:"id"`
+	ActivityID     types.Int64          `tfsdk:"activity_id"`
+	Keys           types.Set            `tfsdk:"keys"`
+	KeyByType      types.Bool           `tfsdk:"key_by_type"`
+	Resolution     types.String         `tfsdk:"resolution"`
+	Time           *activityStreamModel `tfsdk:"time"`
+	Distance       *activityStreamModel `tfsdk:"distance"`
+	LatLng         *activityStreamModel `tfsdk:"latlng"`
+	Altitude       *activityStreamModel `tfsdk:"altitude"`
+	VelocitySmooth *activityStreamModel `tfsdk:"velocity_smooth"`
+	Heartrate      *activityStreamModel `tfsdk:"heartrate"`
+	Cadence        *activityStreamModel `tfsdk:"cadence"`
+	Watts          *activityStreamModel `tfsdk:"watts"`
+	Temp           *activityStreamModel `tfsdk:"temp"`
+	Moving         *activityStreamModel `tfsdk:"moving"`
+	GradeSmooth    *activityStreamModel `tfsdk:"grade_smooth"`
+}
+
+// activityStreamModel maps activity stream schema data.
+type activityStreamModel struct {
+	Data         types.List   `tfsdk:"data"`
+	OriginalSize types.Int64  `tfsdk:"original_size"`
+	SeriesType   types.String `tfsdk:"series_type"`
+	Resolution   types.String `tfsdk:"resolution"`
+}
+
+// Metadata returns the data source type name.
+func (d *activityStreamsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity_streams"
+}
+
+// Schema defines the schema for the data source.
+func (d *activityStreamsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	keys := make([]string, 0, len(activityStreamTypes))
+	for key := range activityStreamTypes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attributes := map[string]schema.Attribute{
+		"athlete_profile": schema.StringAttribute{
+			Description: athleteProfileDescription,
+			Optional:    true,
+		},
+		"id": schema.StringAttribute{
+			Description: "Placeholder identifier attribute.",
+			Computed:    true,
+		},
+		"activity_id": schema.Int64Attribute{
+			Description: "Activity ID.",
+			Required:    true,
+		},
+		"keys": schema.SetAttribute{
+			Description: "Types of streams to fetch, e.g. \"time\" and \"heartrate\". Strava may return more.",
+			ElementType: types.StringType,
+			Required:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.OneOf(keys...)),
+			},
+		},
+		"key_by_type": schema.BoolAttribute{
+			Description: "Whether Strava returns the streams keyed by type. Defaults to true. It only changes the shape of the response, not the streams.",
+			Optional:    true,
+		},
+		"resolution": schema.StringAttribute{
+			Description: "Resolution to reduce the streams to, one of \"low\", \"medium\" or \"high\". Defaults to all data points.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("low", "medium", "high"),
+			},
+		},
+	}
+
+	for key, streamType := range activityStreamTypes {
+		attributes[key] = schema.SingleNestedAttribute{
+			Description: "Stream of " + key + " data, null unless returned by Strava.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"data": schema.ListAttribute{
+					Description: streamType.description,
+					ElementType: streamType.elementType,
+					Computed:    true,
+				},
+				"original_size": schema.Int64Attribute{
+					Description: "Number of data points before any reduction to the resolution.",
+					Computed:    true,
+				},
+				"series_type": schema.StringAttribute{
+					Description: "Base series used when reducing the stream, \"distance\" or \"time\".",
+					Computed:    true,
+				},
+				"resolution": schema.StringAttribute{
+					Description: "Resolution of the stream, \"low\", \"medium\" or \"high\".",
+					Computed:    true,
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the raw data streams of an activity, such as heart rate or power over time. " +
+			"Requires the provider refresh_token with the activity:read scope, or activity:read_all for private activities.",
+		Attributes: attributes,
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *activityStreamsDataSource) requiredScopes() []string {
+	return []string{"activity:read"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *activityStreamsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *activityStreamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state activityStreamsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(state.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(state.Keys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyByType := state.KeyByType.IsNull() || state.KeyByType.ValueBool()
+
+	streams, err := client.getActivityStreams(ctx, state.ActivityID.ValueInt64(), keys, keyByType, state.Resolution.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Activity Streams",
+			"Could not read streams of activity ID "+strconv.FormatInt(state.ActivityID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue("placeholder")
+	models := map[string]*activityStreamModel{}
+	for key, s := range streams {
+		streamType, ok := activityStreamTypes[key]
+		if !ok {
+			continue
+		}
+
+		data := streamType.newData()
+		if err := json.Unmarshal(s.Data, data); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Strava Activity Streams",
+				"Could not decode the "+key+" stream: "+err.Error(),
+			)
+			return
+		}
+
+		list, diags := types.ListValueFrom(ctx, streamType.elementType, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		models[key] = &activityStreamModel{
+			Data:         list,
+			OriginalSize: types.Int64Value(s.OriginalSize),
+			SeriesType:   types.StringValue(s.SeriesType),
+			Resolution:   types.StringValue(s.Resolution),
+		}
+	}
+
+	state.Time = models["time"]
+	state.Distance = models["distance"]
+	state.LatLng = models["latlng"]
+	state.Altitude = models["altitude"]
+	state.VelocitySmooth = models["velocity_smooth"]
+	state.Heartrate = models["heartrate"]
+	state.Cadence = models["cadence"]
+	state.Watts = models["watts"]
+	state.Temp = models["temp"]
+	state.Moving = models["moving"]
+	state.GradeSmooth = models["grade_smooth"]
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *activityStreamsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
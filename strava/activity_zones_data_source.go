@@ -0,0 +1,216 @@
+package strava
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &activityZonesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &activityZonesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &activityZonesDataSource{}
+	_ withRequiredScopes                      = &activityZonesDataSource{}
+)
+
+// NewActivityZonesDataSource is a helper function to simplify the provider implementation.
+func NewActivityZonesDataSource() datasource.DataSource {
+	return &activityZonesDataSource{}
+}
+
+// activityZonesDataSource is the data source implementation.
+type activityZonesDataSource struct {
+	athletes athleteClients
+}
+
+// activityZonesDataSourceModel maps the data source schema data.
+type activityZonesDataSourceModel struct {
+	AthleteProfile types.String        `tfsdk:"athlete_profile"`
+	ID             types.String        `tfsdk:"id"`
+	ActivityID     types.Int64         `tfsdk:"activity_id"`
+	Zones          []activityZoneModel `tfsdk:"zones"`
+}
+
+// activityZoneModel maps activity zone schema data.
+type activityZoneModel struct {
+	Type                types.String          `tfsdk:"type"`
+	Score               types.Int64           `tfsdk:"score"`
+	SensorBased         types.Bool            `tfsdk:"sensor_based"`
+	Points              types.Int64           `tfsdk:"points"`
+	CustomZones         types.Bool            `tfsdk:"custom_zones"`
+	Max                 types.Int64           `tfsdk:"max"`
+	DistributionBuckets []timedZoneRangeModel `tfsdk:"distribution_buckets"`
+}
+
+// timedZoneRangeModel maps distribution bucket schema data.
+type timedZoneRangeModel struct {
+	Min  types.Float64 `tfsdk:"min"`
+	Max  types.Float64 `tfsdk:"max"`
+	Time types.Float64 `tfsdk:"time"`
+}
+
+// Metadata returns the data source type name.
+func (d *activityZonesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity_zones"
+}
+
+// Schema defines the schema for the data source.
+func (d *activityZonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the time spent in each heart rate and power zone during an activity. " +
+			"Zones are a feature of Strava subscriptions. " +
+			"Requires the provider refresh_token with the activity:read scope, or activity:read_all for private activities.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"activity_id": schema.Int64Attribute{
+				Description: "Activity ID.",
+				Required:    true,
+			},
+			"zones": schema.ListNestedAttribute{
+				Description: "Zones of the activity, one per type.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of the zones, e.g. \"heartrate\" or \"power\".",
+							Computed:    true,
+						},
+						"score": schema.Int64Attribute{
+							Description: "Relative effort for heart rate zones, or training load for power zones.",
+							Computed:    true,
+						},
+						"sensor_based": schema.BoolAttribute{
+							Description: "Whether the zones are based on sensor data rather than estimated.",
+							Computed:    true,
+						},
+						"points": schema.Int64Attribute{
+							Description: "Points of the zones, if any.",
+							Computed:    true,
+						},
+						"custom_zones": schema.BoolAttribute{
+							Description: "Whether the athlete has set custom zones.",
+							Computed:    true,
+						},
+						"max": schema.Int64Attribute{
+							Description: "Maximum value used to compute the zones, if any.",
+							Computed:    true,
+						},
+						"distribution_buckets": schema.ListNestedAttribute{
+							Description: "Time spent in each zone.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"min": schema.Float64Attribute{
+										Description: "Lower bound of the zone.",
+										Computed:    true,
+									},
+									"max": schema.Float64Attribute{
+										Description: "Upper bound of the zone, -1 for the open-ended last zone.",
+										Computed:    true,
+									},
+									"time": schema.Float64Attribute{
+										Description: "Time spent in the zone, in seconds.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *activityZonesDataSource) requiredScopes() []string {
+	return []string{"activity:read"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *activityZonesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *activityZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state activityZonesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(state.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zones, err := client.getActivityZones(ctx, state.ActivityID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Activity Zones",
+			"Could not read zones of activity ID "+strconv.FormatInt(state.ActivityID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.ID = types.StringValue("placeholder")
+	state.Zones = []activityZoneModel{}
+	for _, zone := range zones {
+		buckets := []timedZoneRangeModel{}
+		for _, bucket := range zone.DistributionBuckets {
+			buckets = append(buckets, timedZoneRangeModel{
+				Min:  types.Float64Value(bucket.Min),
+				Max:  types.Float64Value(bucket.Max),
+				Time: types.Float64Value(bucket.Time),
+			})
+		}
+
+		state.Zones = append(state.Zones, activityZoneModel{
+			Type:                types.StringValue(zone.Type),
+			Score:               types.Int64PointerValue(zone.Score),
+			SensorBased:         types.BoolValue(zone.SensorBased),
+			Points:              types.Int64PointerValue(zone.Points),
+			CustomZones:         types.BoolPointerValue(zone.CustomZones),
+			Max:                 types.Int64PointerValue(zone.Max),
+			DistributionBuckets: buckets,
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *activityZonesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
@@ -0,0 +1,216 @@
+package strava
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// createActivity creates a manual activity from the form parameters of the
+// Strava API, e.g. name, sport_type, start_date_local and elapsed_time.
+func (c *apiClient) createActivity(ctx context.Context, form url.Values) (*detailedActivity, error) {
+	activity := detailedActivity{}
+	if err := c.do(ctx, http.MethodPost, "/activities", nil, form, &activity); err != nil {
+		return nil, err
+	}
+
+	return &activity, nil
+}
+
+// getActivity returns an activity owned by the athlete, with all segment
+// efforts rather than the most important ones if includeAllEfforts is set.
+func (c *apiClient) getActivity(ctx context.Context, id int64, includeAllEfforts bool) (*detailedActivity, error) {
+	query := url.Values{}
+	if includeAllEfforts {
+		query.Set("include_all_efforts", "true")
+	}
+
+	activity := detailedActivity{}
+	if err := c.get(ctx, "/activities/"+strconv.FormatInt(id, 10), query, &activity); err != nil {
+		return nil, err
+	}
+
+	return &activity, nil
+}
+
+// updateActivity updates an activity owned by the athlete.
+func (c *apiClient) updateActivity(ctx context.Context, id int64, update updatableActivity) (*detailedActivity, error) {
+	activity := detailedActivity{}
+	if err := c.do(ctx, http.MethodPut, "/activities/"+strconv.FormatInt(id, 10), nil, update, &activity); err != nil {
+		return nil, err
+	}
+
+	return &activity, nil
+}
+
+// activitiesPageSize is the maximum number of activities Strava returns per
+// page.
+const activitiesPageSize = 200
+
+// listAthleteActivities pages through the activities of the athlete within
+// the before and after Unix timestamps, if not zero. Activities for which
+// match returns false are skipped, and paging stops once maxItems activities
+// are collected, unless maxItems is zero.
+func (c *apiClient) listAthleteActivities(ctx context.Context, before, after int64, match func(summaryActivity) bool, maxItems int) ([]summaryActivity, error) {
+	activities := []summaryActivity{}
+
+	for page := 1; ; page++ {
+		query := url.Values{}
+		if before != 0 {
+			query.Set("before", strconv.FormatInt(before, 10))
+		}
+		if after != 0 {
+			query.Set("after", strconv.FormatInt(after, 10))
+		}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("per_page", strconv.Itoa(activitiesPageSize))
+
+		var batch []summaryActivity
+		if err := c.get(ctx, "/athlete/activities", query, &batch); err != nil {
+			return nil, err
+		}
+
+		for _, activity := range batch {
+			if match != nil && !match(activity) {
+				continue
+			}
+
+			activities = append(activities, activity)
+			if maxItems > 0 && len(activities) == maxItems {
+				return activities, nil
+			}
+		}
+
+		if len(batch) < activitiesPageSize {
+			return activities, nil
+		}
+	}
+}
+
+// getActivityStreams returns the requested streams of an activity, keyed by
+// type whatever the keyByType request parameter, which only changes the
+// shape of the response. Resolution is low, medium or high, or empty for all
+// data points.
+func (c *apiClient) getActivityStreams(ctx context.Context, id int64, keys []string, keyByType bool, resolution string) (map[string]stream, error) {
+	query := url.Values{}
+	query.Set("keys", strings.Join(keys, ","))
+	query.Set("key_by_type", strconv.FormatBool(keyByType))
+	if resolution != "" {
+		query.Set("resolution", resolution)
+	}
+
+	path := "/activities/" + strconv.FormatInt(id, 10) + "/streams"
+
+	if keyByType {
+		streams := map[string]stream{}
+		if err := c.get(ctx, path, query, &streams); err != nil {
+			return nil, err
+		}
+
+		return streams, nil
+	}
+
+	var list []stream
+	if err := c.get(ctx, path, query, &list); err != nil {
+		return nil, err
+	}
+
+	streams := map[string]stream{}
+	for _, s := range list {
+		streams[s.Type] = s
+	}
+
+	return streams, nil
+}
+
+// getActivityLaps returns the laps of an activity.
+func (c *apiClient) getActivityLaps(ctx context.Context, id int64) ([]lap, error) {
+	laps := []lap{}
+	if err := c.get(ctx, "/activities/"+strconv.FormatInt(id, 10)+"/laps", nil, &laps); err != nil {
+		return nil, err
+	}
+
+	return laps, nil
+}
+
+// getActivityZones returns the time spent in the heart rate and power zones
+// during an activity, a feature of Strava subscriptions.
+func (c *apiClient) getActivityZones(ctx context.Context, id int64) ([]activityZone, error) {
+	zones := []activityZone{}
+	if err := c.get(ctx, "/activities/"+strconv.FormatInt(id, 10)+"/zones", nil, &zones); err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// commentsPageSize and kudoersPageSize are the numbers of comments and
+// kudoers requested per page.
+const (
+	commentsPageSize = 100
+	kudoersPageSize  = 200
+)
+
+// listActivityComments pages through the comments of an activity with the
+// cursor of the last comment of each page. Paging stops once maxItems
+// comments are collected, unless maxItems is zero.
+func (c *apiClient) listActivityComments(ctx context.Context, id int64, maxItems int) ([]comment, error) {
+	comments := []comment{}
+	cursor := ""
+
+	for {
+		query := url.Values{}
+		query.Set("page_size", strconv.Itoa(commentsPageSize))
+		if cursor != "" {
+			query.Set("after_cursor", cursor)
+		}
+
+		var batch []comment
+		if err := c.get(ctx, "/activities/"+strconv.FormatInt(id, 10)+"/comments", query, &batch); err != nil {
+			return nil, err
+		}
+
+		for _, comment := range batch {
+			comments = append(comments, comment)
+			if maxItems > 0 && len(comments) == maxItems {
+				return comments, nil
+			}
+		}
+
+		if len(batch) < commentsPageSize || batch[len(batch)-1].Cursor == "" {
+			return comments, nil
+		}
+		cursor = batch[len(batch)-1].Cursor
+	}
+}
+
+// listActivityKudoers pages through the athletes who gave kudos to an
+// activity. Unlike comments, kudoers only support page based paging. Paging
+// stops once maxItems athletes are collected, unless maxItems is zero.
+func (c *apiClient) listActivityKudoers(ctx context.Context, id int64, maxItems int) ([]summaryAthlete, error) {
+	kudoers := []summaryAthlete{}
+
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(page))
+		query.Set("per_page", strconv.Itoa(kudoersPageSize))
+
+		var batch []summaryAthlete
+		if err := c.get(ctx, "/activities/"+strconv.FormatInt(id, 10)+"/kudos", query, &batch); err != nil {
+			return nil, err
+		}
+
+		for _, kudoer := range batch {
+			kudoers = append(kudoers, kudoer)
+			if maxItems > 0 && len(kudoers) == maxItems {
+				return kudoers, nil
+			}
+		}
+
+		if len(batch) < kudoersPageSize {
+			return kudoers, nil
+		}
+	}
+}
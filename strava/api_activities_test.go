@@ -0,0 +1,484 @@
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAPIClient_ListAthleteActivities(t *testing.T) {
+	const total = 450
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/athlete/activities", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+
+		activities := []summaryActivity{}
+		for id := (page-1)*perPage + 1; id <= page*perPage && id <= total; id++ {
+			activities = append(activities, summaryActivity{ID: int64(id), Commute: id%2 == 0})
+		}
+
+		_ = json.NewEncoder(w).Encode(activities)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := &apiClient{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		tokens:     newTokenSource(nil, "", "access"),
+	}
+
+	commute := true
+
+	testCases := map[string]struct {
+		filter   activityFilter
+		maxItems int
+		expected int
+		last     int64
+	}{
+		"all pages": {
+			expected: total,
+			last:     total,
+		},
+		"filtered": {
+			filter:   activityFilter{Commute: &commute},
+			expected: total / 2,
+			last:     total,
+		},
+		"capped across pages": {
+			filter:   activityFilter{Commute: &commute},
+			maxItems: 150,
+			expected: 150,
+			last:     300,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			activities, err := client.listAthleteActivities(context.Background(), 0, 0, testCase.filter.matches, testCase.maxItems)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(activities) != testCase.expected {
+				t.Fatalf("expected %d activities, got %d", testCase.expected, len(activities))
+			}
+
+			if last := activities[len(activities)-1].ID; last != testCase.last {
+				t.Errorf("expected last activity %d, got %d", testCase.last, last)
+			}
+		})
+	}
+}
+
+func TestAPIClient_GetActivityStreams(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/activities/7/streams", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("key_by_type") == "true" {
+			_, _ = w.Write([]byte(`{
+				"time": {"data": [0, 1, 2], "series_type": "distance", "original_size": 3, "resolution": "high"},
+				"latlng": {"data": [[1.5, 2.5], [1.6, 2.6], [1.7, 2.7]], "series_type": "distance", "original_size": 3, "resolution": "high"}
+			}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`[
+			{"type": "time", "data": [0, 1, 2], "series_type": "distance", "original_size": 3, "resolution": "high"},
+			{"type": "latlng", "data": [[1.5, 2.5], [1.6, 2.6], [1.7, 2.7]], "series_type": "distance", "original_size": 3, "resolution": "high"}
+		]`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := &apiClient{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		tokens:     newTokenSource(nil, "", "access"),
+	}
+
+	for _, keyByType := range []bool{true, false} {
+		keyByType := keyByType
+
+		t.Run("key_by_type="+strconv.FormatBool(keyByType), func(t *testing.T) {
+			t.Parallel()
+
+			streams, err := client.getActivityStreams(context.Background(), 7, []string{"time", "latlng"}, keyByType, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(streams) != 2 {
+				t.Fatalf("expected 2 streams, got %d", len(streams))
+			}
+
+			for key, s := range streams {
+				streamType := activityStreamTypes[key]
+
+				data := streamType.newData()
+				if err := json.Unmarshal(s.Data, data); err != nil {
+					t.Fatalf("unexpected error decoding %s: %s", key, err)
+				}
+
+				list, diags := types.ListValueFrom(context.Background(), streamType.elementType, data)
+				if diags.HasError() {
+					t.Fatalf("unexpected error converting %s: %v", key, diags)
+				}
+
+				if len(list.Elements()) != 3 || s.OriginalSize != 3 {
+					t.Errorf("expected 3 data points in %s, got %d", key, len(list.Elements()))
+				}
+			}
+		})
+	}
+}
+
+func TestAPIClient_ListActivityComments(t *testing.T) {
+	const total = 250
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/activities/7/comments", func(w http.ResponseWriter, r *http.Request) {
+		after, _ := strconv.Atoi(r.URL.Query().Get("after_cursor"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+		comments := []comment{}
+		for id := after + 1; id <= after+pageSize && id <= total; id++ {
+			comments = append(comments, comment{ID: int64(id), Cursor: strconv.Itoa(id)})
+		}
+
+		_ = json.NewEncoder(w).Encode(comments)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := &apiClient{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		tokens:     newTokenSource(nil, "", "access"),
+	}
+
+	testCases := map[string]struct {
+		maxItems int
+		expected int
+	}{
+		"all pages": {
+			expected: total,
+		},
+		"capped across pages": {
+			maxItems: 150,
+			expected: 150,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			comments, err := client.listActivityComments(context.Background(), 7, testCase.maxItems)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(comments) != testCase.expected {
+				t.Fatalf("expected %d comments, got %d", testCase.expected, len(comments))
+			}
+
+			for i, comment := range comments {
+				if comment.ID != int64(i+1) {
+					t.Fatalf("expected comment %d at index %d, got %d", i+1, i, comment.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIClient_ListActivityKudoers(t *testing.T) {
+	const total = 450
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/activities/7/kudos", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+
+		kudoers := []summaryAthlete{}
+		for id := (page-1)*perPage + 1; id <= page*perPage && id <= total; id++ {
+			kudoers = append(kudoers, summaryAthlete{Firstname: strconv.Itoa(id)})
+		}
+
+		_ = json.NewEncoder(w).Encode(kudoers)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := &apiClient{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		tokens:     newTokenSource(nil, "", "access"),
+	}
+
+	kudoers, err := client.listActivityKudoers(context.Background(), 7, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(kudoers) != total {
+		t.Fatalf("expected %d kudoers, got %d", total, len(kudoers))
+	}
+
+	if last := kudoers[len(kudoers)-1].Firstname; last != strconv.Itoa(total) {
+		t.Errorf("expected last kudoer %d, got %s", total, last)
+	}
+}
+
+func TestAPIClient_CreateActivity(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/activities", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+
+		for key, expected := range map[string]string{
+			"name":             "Lunch Run",
+			"sport_type":       "Run",
+			"start_date_local": "2023-05-01T12:00:00Z",
+			"elapsed_time":     "1800",
+			"distance":         "5000.5",
+		} {
+			if got := r.FormValue(key); got != expected {
+				t.Errorf("expected %s %q, got %q", key, expected, got)
+			}
+		}
+
+		_, _ = w.Write([]byte(`{"id": 7, "name": "Lunch Run", "sport_type": "Run", "start_date_local": "2023-05-01T12:00:00Z", "elapsed_time": 1800, "distance": 5000.5}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := &apiClient{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		tokens:     newTokenSource(nil, "", "access"),
+	}
+
+	form := url.Values{}
+	form.Set("name", "Lunch Run")
+	form.Set("sport_type", "Run")
+	form.Set("start_date_local", "2023-05-01T12:00:00Z")
+	form.Set("elapsed_time", "1800")
+	form.Set("distance", "5000.5")
+
+	activity, err := client.createActivity(context.Background(), form)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if activity.ID != 7 || activity.Name != "Lunch Run" || activity.ElapsedTime != 1800 || activity.Distance != 5000.5 {
+		t.Errorf("unexpected activity: %+v", activity.summaryActivity)
+	}
+}
+
+func TestAPIClient_UpdateActivity(t *testing.T) {
+	testCases := map[string]struct {
+		update   updatableActivity
+		expected string
+	}{
+		"with gear": {
+			update:   updatableActivity{Name: "Lunch Run", SportType: "Run", Description: "Easy", Commute: true, HideFromHome: true, GearID: "g1"},
+			expected: `{"name":"Lunch Run","sport_type":"Run","description":"Easy","trainer":false,"commute":true,"hide_from_home":true,"gear_id":"g1"}`,
+		},
+		"without gear": {
+			update:   updatableActivity{Name: "Lunch Run", SportType: "Run"},
+			expected: `{"name":"Lunch Run","sport_type":"Run","description":"","trainer":false,"commute":false,"hide_from_home":false}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/activities/7", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut {
+					t.Errorf("expected PUT, got %s", r.Method)
+				}
+
+				body, _ := io.ReadAll(r.Body)
+				if got := strings.TrimSpace(string(body)); got != testCase.expected {
+					t.Errorf("expected body %s, got %s", testCase.expected, got)
+				}
+
+				_, _ = w.Write([]byte(`{"id": 7, "name": "Lunch Run", "sport_type": "Run", "hide_from_home": true}`))
+			})
+
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			client := &apiClient{
+				httpClient: server.Client(),
+				baseURL:    server.URL,
+				tokens:     newTokenSource(nil, "", "access"),
+			}
+
+			activity, err := client.updateActivity(context.Background(), 7, testCase.update)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if activity.ID != 7 || !activity.HideFromHome {
+				t.Errorf("unexpected activity: %+v", activity)
+			}
+		})
+	}
+}
+
+func TestAPIClient_GetActivity(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/activities/7", func(w http.ResponseWriter, r *http.Request) {
+		efforts := 1
+		if r.URL.Query().Get("include_all_efforts") == "true" {
+			efforts = 3
+		}
+
+		if _, ok := r.URL.Query()["include_all_efforts"]; ok && efforts == 1 {
+			t.Errorf("expected no include_all_efforts parameter, got %q", r.URL.RawQuery)
+		}
+
+		activity := detailedActivity{summaryActivity: summaryActivity{ID: 7}}
+		for i := 0; i < efforts; i++ {
+			activity.SegmentEfforts = append(activity.SegmentEfforts, detailedSegmentEffort{ID: int64(i + 1)})
+		}
+
+		_ = json.NewEncoder(w).Encode(activity)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := &apiClient{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		tokens:     newTokenSource(nil, "", "access"),
+	}
+
+	testCases := map[string]struct {
+		includeAllEfforts bool
+		expected          int
+	}{
+		"important efforts": {
+			expected: 1,
+		},
+		"all efforts": {
+			includeAllEfforts: true,
+			expected:          3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			activity, err := client.getActivity(context.Background(), 7, testCase.includeAllEfforts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if activity.ID != 7 || len(activity.SegmentEfforts) != testCase.expected {
+				t.Errorf("expected activity 7 with %d efforts, got activity %d with %d", testCase.expected, activity.ID, len(activity.SegmentEfforts))
+			}
+		})
+	}
+}
+
+func TestAPIClient_GetActivityZones(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/activities/7/zones", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{
+				"type": "heartrate",
+				"score": 42,
+				"sensor_based": true,
+				"points": 3,
+				"custom_zones": false,
+				"max": 190,
+				"distribution_buckets": [
+					{"min": 0, "max": 120, "time": 60},
+					{"min": 120, "max": -1, "time": 1740}
+				]
+			},
+			{
+				"type": "pace",
+				"score": null,
+				"sensor_based": false,
+				"points": null,
+				"max": null,
+				"distribution_buckets": [
+					{"min": 0, "max": 2.5, "time": 300},
+					{"min": 2.5, "max": -1, "time": 1500}
+				]
+			}
+		]`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := &apiClient{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		tokens:     newTokenSource(nil, "", "access"),
+	}
+
+	zones, err := client.getActivityZones(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(zones) != 2 {
+		t.Fatalf("expected 2 zones, got %d", len(zones))
+	}
+
+	heartrate := zones[0]
+	if heartrate.Score == nil || *heartrate.Score != 42 || heartrate.Points == nil || *heartrate.Points != 3 || heartrate.Max == nil || *heartrate.Max != 190 {
+		t.Errorf("expected score 42, points 3 and max 190, got %+v", heartrate)
+	}
+
+	if heartrate.CustomZones == nil || *heartrate.CustomZones {
+		t.Errorf("expected default zones, got %+v", heartrate.CustomZones)
+	}
+
+	pace := zones[1]
+	if pace.Score != nil || pace.Points != nil || pace.Max != nil || pace.CustomZones != nil {
+		t.Errorf("expected null score, points, max and custom zones, got %+v", pace)
+	}
+
+	// The last bucket is open ended, which Strava marks with a -1 upper bound
+	expected := []timedZoneRange{{Min: 0, Max: 2.5, Time: 300}, {Min: 2.5, Max: -1, Time: 1500}}
+	if !reflect.DeepEqual(pace.DistributionBuckets, expected) {
+		t.Errorf("expected buckets %+v, got %+v", expected, pace.DistributionBuckets)
+	}
+}
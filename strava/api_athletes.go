@@ -0,0 +1,54 @@
+package strava
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// getAuthenticatedAthlete returns the athlete who owns the access token.
+func (c *apiClient) getAuthenticatedAthlete(ctx context.Context) (*detailedAthlete, error) {
+	athlete := detailedAthlete{}
+	if err := c.get(ctx, "/athlete", nil, &athlete); err != nil {
+		return nil, err
+	}
+
+	return &athlete, nil
+}
+
+// updateAuthenticatedAthlete updates the weight, in kilograms, of the athlete
+// who owns the access token.
+func (c *apiClient) updateAuthenticatedAthlete(ctx context.Context, weight float64) (*detailedAthlete, error) {
+	form := url.Values{}
+	form.Set("weight", strconv.FormatFloat(weight, 'f', -1, 64))
+
+	athlete := detailedAthlete{}
+	if err := c.do(ctx, http.MethodPut, "/athlete", nil, form, &athlete); err != nil {
+		return nil, err
+	}
+
+	return &athlete, nil
+}
+
+// getAthleteStats returns the activity stats of an athlete. Strava only
+// returns the stats of the athlete who owns the access token.
+func (c *apiClient) getAthleteStats(ctx context.Context, id int64) (*activityStats, error) {
+	stats := activityStats{}
+	if err := c.get(ctx, "/athletes/"+strconv.FormatInt(id, 10)+"/stats", nil, &stats); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// getAuthenticatedAthleteZones returns the heart rate and power zones of the
+// athlete who owns the access token.
+func (c *apiClient) getAuthenticatedAthleteZones(ctx context.Context) (*athleteZones, error) {
+	zones := athleteZones{}
+	if err := c.get(ctx, "/athlete/zones", nil, &zones); err != nil {
+		return nil, err
+	}
+
+	return &zones, nil
+}
@@ -0,0 +1,209 @@
+package strava
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiError is returned for Strava API responses with an unexpected status.
+type apiError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error implements error.
+func (e *apiError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// isNotFound reports whether err is a Strava API 404 response.
+func isNotFound(err error) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// Diagnostic reported by athlete-scoped resources and data sources when no
+// athlete tokens are configured.
+const (
+	missingAthleteCredentialsSummary = "Missing Strava Athlete Credentials"
+	missingAthleteCredentialsDetail  = "This resource or data source acts on behalf of an athlete, which requires OAuth tokens. " +
+		"Set the refresh_token value in the provider configuration or use the STRAVA_REFRESH_TOKEN environment variable, " +
+		"or select an athlete block of the provider with the athlete_profile attribute. " +
+		"A refresh token can be obtained with the strava_oauth_token resource."
+)
+
+// athleteProfileDescription describes the athlete_profile attribute of
+// athlete-scoped resources and data sources.
+const athleteProfileDescription = "Name of the provider athlete block whose tokens to use. " +
+	"Defaults to the athlete set with the provider refresh_token."
+
+// athleteClients maps athlete profile names to the API clients acting on
+// behalf of them. The athlete set with the provider refresh_token is named
+// defaultAthleteProfile.
+type athleteClients map[string]*apiClient
+
+// client returns the API client of the athlete profile, or of the default
+// athlete when profile is null.
+func (c athleteClients) client(profile types.String) (*apiClient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := defaultAthleteProfile
+	if !profile.IsNull() {
+		name = profile.ValueString()
+	}
+
+	if client, ok := c[name]; ok {
+		return client, diags
+	}
+
+	if profile.IsNull() {
+		diags.AddError(missingAthleteCredentialsSummary, missingAthleteCredentialsDetail)
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(c))
+	for n := range c {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	diags.AddAttributeError(
+		path.Root("athlete_profile"),
+		"Unknown Strava Athlete Profile",
+		"No provider athlete block is named "+name+". Configured athlete profiles: "+strings.Join(names, ", ")+".",
+	)
+
+	return nil, diags
+}
+
+// validateScopes reports an unknown athlete profile, or scopes the athlete
+// is known not to have granted, at plan time. Nothing is reported before the
+// provider is configured or while the profile is unknown.
+func (c athleteClients) validateScopes(profile types.String, required ...string) diag.Diagnostics {
+	if c == nil || profile.IsUnknown() {
+		return nil
+	}
+
+	client, diags := c.client(profile)
+	if diags.HasError() {
+		return diags
+	}
+
+	return validateScopes(client, path.Root("athlete_profile"), required...)
+}
+
+// apiClient calls athlete-scoped Strava API endpoints on behalf of the athlete
+// whose tokens it holds.
+type apiClient struct {
+	httpClient *http.Client
+	baseURL    string
+	tokens     *tokenSource
+
+	// scopes are the OAuth scopes granted by the athlete, or nil when they
+	// are not known. Strava offers no way to look them up.
+	scopes []string
+}
+
+// get sends a GET request and decodes the JSON response into out.
+func (c *apiClient) get(ctx context.Context, path string, query url.Values, out any) error {
+	return c.do(ctx, http.MethodGet, path, query, nil, out)
+}
+
+// do sends a request with an optional body, sent as a form when it is
+// url.Values and as JSON otherwise, and decodes the JSON response into out,
+// unless out is nil. A request rejected with 401 is retried once with a
+// refreshed access token.
+func (c *apiClient) do(ctx context.Context, method, path string, query url.Values, reqBody any, out any) error {
+	accessToken, err := c.tokens.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.send(ctx, method, path, query, reqBody, accessToken)
+
+	var apiErr *apiError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized {
+		if err := c.tokens.invalidate(ctx, accessToken); err != nil {
+			return err
+		}
+
+		if accessToken, err = c.tokens.accessToken(ctx); err != nil {
+			return err
+		}
+
+		body, err = c.send(ctx, method, path, query, reqBody, accessToken)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// send sends a single authenticated request and returns the response body.
+func (c *apiClient) send(ctx context.Context, method, path string, query url.Values, reqBody any, accessToken string) ([]byte, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	var contentType string
+	switch b := reqBody.(type) {
+	case nil:
+	case url.Values:
+		body = strings.NewReader(b.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, &apiError{StatusCode: res.StatusCode, Body: string(resBody)}
+	}
+
+	return resBody, nil
+}
@@ -0,0 +1,168 @@
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newTestAPIClient returns an API client talking to a fake Strava server that
+// accepts the access tokens it issued last and rejects the others.
+func newTestAPIClient(t *testing.T, refreshToken, accessToken string) (*apiClient, *int32) {
+	t.Helper()
+
+	var refreshes int32
+	var issued atomic.Value
+	issued.Store("")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("grant_type") != "refresh_token" || r.FormValue("refresh_token") != refreshToken || r.FormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		n := atomic.AddInt32(&refreshes, 1)
+		token := "access-" + strconv.Itoa(int(n))
+		issued.Store(token)
+
+		_ = json.NewEncoder(w).Encode(oauthToken{
+			AccessToken:  token,
+			RefreshToken: refreshToken,
+			ExpiresAt:    time.Now().Add(6 * time.Hour).Unix(),
+		})
+	})
+	mux.HandleFunc("/api/v3/athlete", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+issued.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":42}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	oauth := &oauthClient{
+		httpClient:   server.Client(),
+		baseURL:      oauthBaseURL(server.URL + "/api/v3"),
+		clientID:     "5",
+		clientSecret: "secret",
+	}
+
+	return &apiClient{
+		httpClient: server.Client(),
+		baseURL:    server.URL + "/api/v3",
+		tokens:     newTokenSource(oauth, refreshToken, accessToken),
+	}, &refreshes
+}
+
+func TestAPIClient_RefreshesMissingAccessToken(t *testing.T) {
+	client, refreshes := newTestAPIClient(t, "refresh", "")
+
+	for i := 0; i < 2; i++ {
+		var athlete struct {
+			ID int64 `json:"id"`
+		}
+		if err := client.get(context.Background(), "/athlete", nil, &athlete); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if athlete.ID != 42 {
+			t.Errorf("expected athlete 42, got %d", athlete.ID)
+		}
+	}
+
+	if *refreshes != 1 {
+		t.Errorf("expected 1 refresh, got %d", *refreshes)
+	}
+}
+
+func TestAPIClient_RefreshesRejectedAccessToken(t *testing.T) {
+	client, refreshes := newTestAPIClient(t, "refresh", "stale")
+
+	if err := client.get(context.Background(), "/athlete", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if *refreshes != 1 {
+		t.Errorf("expected 1 refresh, got %d", *refreshes)
+	}
+}
+
+func TestAPIClient_RefreshesExpiringAccessToken(t *testing.T) {
+	client, refreshes := newTestAPIClient(t, "refresh", "")
+
+	if err := client.get(context.Background(), "/athlete", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client.tokens.now = func() time.Time { return time.Now().Add(6*time.Hour - time.Minute) }
+
+	if err := client.get(context.Background(), "/athlete", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if *refreshes != 2 {
+		t.Errorf("expected 2 refreshes, got %d", *refreshes)
+	}
+}
+
+func TestAthleteClients_Client(t *testing.T) {
+	defaultClient := &apiClient{baseURL: "default"}
+	coachClient := &apiClient{baseURL: "coach"}
+
+	testCases := map[string]struct {
+		clients  athleteClients
+		profile  types.String
+		expected *apiClient
+		errors   bool
+	}{
+		"default": {
+			clients:  athleteClients{defaultAthleteProfile: defaultClient, "coach": coachClient},
+			profile:  types.StringNull(),
+			expected: defaultClient,
+		},
+		"named": {
+			clients:  athleteClients{defaultAthleteProfile: defaultClient, "coach": coachClient},
+			profile:  types.StringValue("coach"),
+			expected: coachClient,
+		},
+		"missing default": {
+			clients: athleteClients{"coach": coachClient},
+			profile: types.StringNull(),
+			errors:  true,
+		},
+		"unknown name": {
+			clients: athleteClients{defaultAthleteProfile: defaultClient},
+			profile: types.StringValue("coach"),
+			errors:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.clients.client(testCase.profile)
+
+			if diags.HasError() != testCase.errors {
+				t.Fatalf("expected errors %t, got %v", testCase.errors, diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected client %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package strava
+
+import (
+	"encoding/json"
+)
+
+// summaryGear maps the SummaryGear model of the Strava API.
+type summaryGear struct {
+	ID       string  `json:"id"`
+	Primary  bool    `json:"primary"`
+	Name     string  `json:"name"`
+	Distance float64 `json:"distance"`
+}
+
+// detailedAthlete maps the DetailedAthlete model of the Strava API.
+type detailedAthlete struct {
+	ID                    int64         `json:"id"`
+	Username              *string       `json:"username"`
+	Firstname             string        `json:"firstname"`
+	Lastname              string        `json:"lastname"`
+	City                  *string       `json:"city"`
+	State                 *string       `json:"state"`
+	Country               *string       `json:"country"`
+	Sex                   *string       `json:"sex"`
+	Premium               bool          `json:"premium"`
+	Summit                bool          `json:"summit"`
+	CreatedAt             string        `json:"created_at"`
+	UpdatedAt             string        `json:"updated_at"`
+	MeasurementPreference string        `json:"measurement_preference"`
+	FTP                   *int64        `json:"ftp"`
+	Weight                *float64      `json:"weight"`
+	ProfileMedium         string        `json:"profile_medium"`
+	Profile               string        `json:"profile"`
+	Bikes                 []summaryGear `json:"bikes"`
+	Shoes                 []summaryGear `json:"shoes"`
+}
+
+// activityTotal maps the ActivityTotal model of the Strava API.
+type activityTotal struct {
+	Count            int64   `json:"count"`
+	Distance         float64 `json:"distance"`
+	MovingTime       int64   `json:"moving_time"`
+	ElapsedTime      int64   `json:"elapsed_time"`
+	ElevationGain    float64 `json:"elevation_gain"`
+	AchievementCount *int64  `json:"achievement_count"`
+}
+
+// activityStats maps the ActivityStats model of the Strava API.
+type activityStats struct {
+	BiggestRideDistance       *float64      `json:"biggest_ride_distance"`
+	BiggestClimbElevationGain *float64      `json:"biggest_climb_elevation_gain"`
+	RecentRideTotals          activityTotal `json:"recent_ride_totals"`
+	RecentRunTotals           activityTotal `json:"recent_run_totals"`
+	RecentSwimTotals          activityTotal `json:"recent_swim_totals"`
+	YTDRideTotals             activityTotal `json:"ytd_ride_totals"`
+	YTDRunTotals              activityTotal `json:"ytd_run_totals"`
+	YTDSwimTotals             activityTotal `json:"ytd_swim_totals"`
+	AllRideTotals             activityTotal `json:"all_ride_totals"`
+	AllRunTotals              activityTotal `json:"all_run_totals"`
+	AllSwimTotals             activityTotal `json:"all_swim_totals"`
+}
+
+// zoneRange maps the ZoneRange model of the Strava API.
+type zoneRange struct {
+	Min int64 `json:"min"`
+	Max int64 `json:"max"`
+}
+
+// heartRateZoneRanges maps the HeartRateZoneRanges model of the Strava API.
+type heartRateZoneRanges struct {
+	CustomZones bool        `json:"custom_zones"`
+	Zones       []zoneRange `json:"zones"`
+}
+
+// powerZoneRanges maps the PowerZoneRanges model of the Strava API.
+type powerZoneRanges struct {
+	Zones []zoneRange `json:"zones"`
+}
+
+// athleteZones maps the Zones model of the Strava API.
+type athleteZones struct {
+	HeartRate *heartRateZoneRanges `json:"heart_rate"`
+	Power     *powerZoneRanges     `json:"power"`
+}
+
+// polylineMap maps the PolylineMap model of the Strava API.
+type polylineMap struct {
+	ID              string  `json:"id"`
+	Polyline        *string `json:"polyline"`
+	SummaryPolyline *string `json:"summary_polyline"`
+}
+
+// summaryActivity maps the SummaryActivity model of the Strava API.
+type summaryActivity struct {
+	ID                 int64       `json:"id"`
+	Name               string      `json:"name"`
+	SportType          string      `json:"sport_type"`
+	StartDate          string      `json:"start_date"`
+	StartDateLocal     string      `json:"start_date_local"`
+	Timezone           string      `json:"timezone"`
+	Distance           float64     `json:"distance"`
+	MovingTime         int64       `json:"moving_time"`
+	ElapsedTime        int64       `json:"elapsed_time"`
+	TotalElevationGain float64     `json:"total_elevation_gain"`
+	ElevHigh           *float64    `json:"elev_high"`
+	ElevLow            *float64    `json:"elev_low"`
+	AverageSpeed       float64     `json:"average_speed"`
+	MaxSpeed           float64     `json:"max_speed"`
+	Trainer            bool        `json:"trainer"`
+	Commute            bool        `json:"commute"`
+	Manual             bool        `json:"manual"`
+	Private            bool        `json:"private"`
+	GearID             *string     `json:"gear_id"`
+	Map                polylineMap `json:"map"`
+}
+
+// detailedActivity maps the DetailedActivity model of the Strava API.
+type detailedActivity struct {
+	summaryActivity
+
+	Description    *string                 `json:"description"`
+	HideFromHome   bool                    `json:"hide_from_home"`
+	Calories       float64                 `json:"calories"`
+	DeviceName     *string                 `json:"device_name"`
+	Gear           *summaryGear            `json:"gear"`
+	SplitsMetric   []split                 `json:"splits_metric"`
+	SplitsStandard []split                 `json:"splits_standard"`
+	Laps           []lap                   `json:"laps"`
+	SegmentEfforts []detailedSegmentEffort `json:"segment_efforts"`
+	BestEfforts    []detailedSegmentEffort `json:"best_efforts"`
+}
+
+// split maps the Split model of the Strava API.
+type split struct {
+	Split               int64   `json:"split"`
+	Distance            float64 `json:"distance"`
+	ElapsedTime         int64   `json:"elapsed_time"`
+	MovingTime          int64   `json:"moving_time"`
+	ElevationDifference float64 `json:"elevation_difference"`
+	AverageSpeed        float64 `json:"average_speed"`
+	PaceZone            int64   `json:"pace_zone"`
+}
+
+// lap maps the Lap model of the Strava API.
+type lap struct {
+	ID                 int64    `json:"id"`
+	Name               string   `json:"name"`
+	LapIndex           int64    `json:"lap_index"`
+	StartDate          string   `json:"start_date"`
+	StartDateLocal     string   `json:"start_date_local"`
+	Distance           float64  `json:"distance"`
+	ElapsedTime        int64    `json:"elapsed_time"`
+	MovingTime         int64    `json:"moving_time"`
+	TotalElevationGain float64  `json:"total_elevation_gain"`
+	AverageSpeed       float64  `json:"average_speed"`
+	MaxSpeed           float64  `json:"max_speed"`
+	AverageCadence     *float64 `json:"average_cadence"`
+	AverageHeartrate   *float64 `json:"average_heartrate"`
+	MaxHeartrate       *float64 `json:"max_heartrate"`
+	AverageWatts       *float64 `json:"average_watts"`
+	StartIndex         int64    `json:"start_index"`
+	EndIndex           int64    `json:"end_index"`
+}
+
+// summarySegment maps the SummarySegment model of the Strava API.
+type summarySegment struct {
+	ID            int64   `json:"id"`
+	Name          string  `json:"name"`
+	Distance      float64 `json:"distance"`
+	AverageGrade  float64 `json:"average_grade"`
+	ClimbCategory int64   `json:"climb_category"`
+}
+
+// detailedSegmentEffort maps the DetailedSegmentEffort model of the Strava
+// API, which also describes best efforts.
+type detailedSegmentEffort struct {
+	ID               int64           `json:"id"`
+	Name             string          `json:"name"`
+	StartDate        string          `json:"start_date"`
+	StartDateLocal   string          `json:"start_date_local"`
+	Distance         float64         `json:"distance"`
+	ElapsedTime      int64           `json:"elapsed_time"`
+	MovingTime       int64           `json:"moving_time"`
+	StartIndex       int64           `json:"start_index"`
+	EndIndex         int64           `json:"end_index"`
+	AverageHeartrate *float64        `json:"average_heartrate"`
+	MaxHeartrate     *float64        `json:"max_heartrate"`
+	AverageWatts     *float64        `json:"average_watts"`
+	PRRank           *int64          `json:"pr_rank"`
+	KOMRank          *int64          `json:"kom_rank"`
+	Segment          *summarySegment `json:"segment"`
+}
+
+// updatableActivity maps the UpdatableActivity model of the Strava API.
+type updatableActivity struct {
+	Name         string `json:"name"`
+	SportType    string `json:"sport_type"`
+	Description  string `json:"description"`
+	Trainer      bool   `json:"trainer"`
+	Commute      bool   `json:"commute"`
+	HideFromHome bool   `json:"hide_from_home"`
+	GearID       string `json:"gear_id,omitempty"`
+}
+
+// stream maps the stream models of the Strava API, e.g. TimeStream, with the
+// type of stream sets not keyed by type. Data is decoded according to the
+// stream type.
+type stream struct {
+	Type         string          `json:"type"`
+	Data         json.RawMessage `json:"data"`
+	SeriesType   string          `json:"series_type"`
+	OriginalSize int64           `json:"original_size"`
+	Resolution   string          `json:"resolution"`
+}
+
+// timedZoneRange maps the TimedZoneRange model of the Strava API. Bounds are
+// numbers rather than integers as pace zones are in meters per second.
+type timedZoneRange struct {
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Time float64 `json:"time"`
+}
+
+// activityZone maps the ActivityZone model of the Strava API.
+type activityZone struct {
+	Type                string           `json:"type"`
+	Score               *int64           `json:"score"`
+	SensorBased         bool             `json:"sensor_based"`
+	Points              *int64           `json:"points"`
+	CustomZones         *bool            `json:"custom_zones"`
+	Max                 *int64           `json:"max"`
+	DistributionBuckets []timedZoneRange `json:"distribution_buckets"`
+}
+
+// summaryAthlete maps the SummaryAthlete model of the Strava API. Kudoers
+// only have names, with the last name reduced to its initial.
+type summaryAthlete struct {
+	ID        int64  `json:"id"`
+	Firstname string `json:"firstname"`
+	Lastname  string `json:"lastname"`
+}
+
+// comment maps the Comment model of the Strava API, with the cursor to
+// request the comments after it.
+type comment struct {
+	ID         int64          `json:"id"`
+	ActivityID int64          `json:"activity_id"`
+	Text       string         `json:"text"`
+	Athlete    summaryAthlete `json:"athlete"`
+	CreatedAt  string         `json:"created_at"`
+	Cursor     string         `json:"cursor"`
+}
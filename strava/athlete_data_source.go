@@ -0,0 +1,275 @@
+package strava
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &athleteDataSource{}
+	_ datasource.DataSourceWithConfigure      = &athleteDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &athleteDataSource{}
+	_ withRequiredScopes                      = &athleteDataSource{}
+)
+
+// NewAthleteDataSource is a helper function to simplify the provider implementation.
+func NewAthleteDataSource() datasource.DataSource {
+	return &athleteDataSource{}
+}
+
+// athleteDataSource is the data source implementation.
+type athleteDataSource struct {
+	athletes athleteClients
+}
+
+// athleteDataSourceModel maps the data source schema data.
+type athleteDataSourceModel struct {
+	AthleteProfile        types.String       `tfsdk:"athlete_profile"`
+	ID                    types.Int64        `tfsdk:"id"`
+	Username              types.String       `tfsdk:"username"`
+	Firstname             types.String       `tfsdk:"firstname"`
+	Lastname              types.String       `tfsdk:"lastname"`
+	City                  types.String       `tfsdk:"city"`
+	State                 types.String       `tfsdk:"state"`
+	Country               types.String       `tfsdk:"country"`
+	Sex                   types.String       `tfsdk:"sex"`
+	Premium               types.Bool         `tfsdk:"premium"`
+	Summit                types.Bool         `tfsdk:"summit"`
+	CreatedAt             types.String       `tfsdk:"created_at"`
+	UpdatedAt             types.String       `tfsdk:"updated_at"`
+	MeasurementPreference types.String       `tfsdk:"measurement_preference"`
+	FTP                   types.Int64        `tfsdk:"ftp"`
+	Weight                types.Float64      `tfsdk:"weight"`
+	ProfileMedium         types.String       `tfsdk:"profile_medium"`
+	Profile               types.String       `tfsdk:"profile"`
+	Bikes                 []gearSummaryModel `tfsdk:"bikes"`
+	Shoes                 []gearSummaryModel `tfsdk:"shoes"`
+}
+
+// gearSummaryModel maps gear summary schema data.
+type gearSummaryModel struct {
+	ID       types.String  `tfsdk:"id"`
+	Name     types.String  `tfsdk:"name"`
+	Primary  types.Bool    `tfsdk:"primary"`
+	Distance types.Float64 `tfsdk:"distance"`
+}
+
+// Metadata returns the data source type name.
+func (d *athleteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_athlete"
+}
+
+// Schema defines the schema for the data source.
+func (d *athleteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	gearAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Gear ID.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the gear.",
+			Computed:    true,
+		},
+		"primary": schema.BoolAttribute{
+			Description: "Whether this is the athlete's default gear.",
+			Computed:    true,
+		},
+		"distance": schema.Float64Attribute{
+			Description: "Distance logged with the gear, in meters.",
+			Computed:    true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the athlete the provider acts on behalf of. Requires the provider refresh_token.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+			},
+			"id": schema.Int64Attribute{
+				Description: "Athlete ID.",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username of the athlete.",
+				Computed:    true,
+			},
+			"firstname": schema.StringAttribute{
+				Description: "First name of the athlete.",
+				Computed:    true,
+			},
+			"lastname": schema.StringAttribute{
+				Description: "Last name of the athlete.",
+				Computed:    true,
+			},
+			"city": schema.StringAttribute{
+				Description: "City of the athlete.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State or region of the athlete.",
+				Computed:    true,
+			},
+			"country": schema.StringAttribute{
+				Description: "Country of the athlete.",
+				Computed:    true,
+			},
+			"sex": schema.StringAttribute{
+				Description: "Sex of the athlete, \"M\" or \"F\".",
+				Computed:    true,
+			},
+			"premium": schema.BoolAttribute{
+				Description: "Deprecated by Strava, use summit instead. Whether the athlete has a paid subscription.",
+				Computed:    true,
+			},
+			"summit": schema.BoolAttribute{
+				Description: "Whether the athlete has a paid subscription.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date and time the athlete was created.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Date and time the athlete was last updated.",
+				Computed:    true,
+			},
+			"measurement_preference": schema.StringAttribute{
+				Description: "Preferred unit system of the athlete, \"feet\" or \"meters\".",
+				Computed:    true,
+			},
+			"ftp": schema.Int64Attribute{
+				Description: "Functional threshold power of the athlete, in watts.",
+				Computed:    true,
+			},
+			"weight": schema.Float64Attribute{
+				Description: "Weight of the athlete, in kilograms.",
+				Computed:    true,
+			},
+			"profile_medium": schema.StringAttribute{
+				Description: "URL of a 62x62 pixel profile picture.",
+				Computed:    true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "URL of a 124x124 pixel profile picture.",
+				Computed:    true,
+			},
+			"bikes": schema.ListNestedAttribute{
+				Description: "Bikes of the athlete.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: gearAttributes,
+				},
+			},
+			"shoes": schema.ListNestedAttribute{
+				Description: "Shoes of the athlete.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: gearAttributes,
+				},
+			},
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *athleteDataSource) requiredScopes() []string {
+	return []string{"read"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *athleteDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *athleteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config athleteDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(config.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	athlete, err := client.getAuthenticatedAthlete(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Athlete",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := athleteDataSourceModel{
+		AthleteProfile:        config.AthleteProfile,
+		ID:                    types.Int64Value(athlete.ID),
+		Username:              types.StringPointerValue(athlete.Username),
+		Firstname:             types.StringValue(athlete.Firstname),
+		Lastname:              types.StringValue(athlete.Lastname),
+		City:                  types.StringPointerValue(athlete.City),
+		State:                 types.StringPointerValue(athlete.State),
+		Country:               types.StringPointerValue(athlete.Country),
+		Sex:                   types.StringPointerValue(athlete.Sex),
+		Premium:               types.BoolValue(athlete.Premium),
+		Summit:                types.BoolValue(athlete.Summit),
+		CreatedAt:             types.StringValue(athlete.CreatedAt),
+		UpdatedAt:             types.StringValue(athlete.UpdatedAt),
+		MeasurementPreference: types.StringValue(athlete.MeasurementPreference),
+		FTP:                   types.Int64PointerValue(athlete.FTP),
+		Weight:                types.Float64PointerValue(athlete.Weight),
+		ProfileMedium:         types.StringValue(athlete.ProfileMedium),
+		Profile:               types.StringValue(athlete.Profile),
+		Bikes:                 newGearSummaryModels(athlete.Bikes),
+		Shoes:                 newGearSummaryModels(athlete.Shoes),
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *athleteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
+
+// newGearSummaryModels maps gear summaries to their schema data.
+func newGearSummaryModels(gear []summaryGear) []gearSummaryModel {
+	models := []gearSummaryModel{}
+	for _, g := range gear {
+		models = append(models, gearSummaryModel{
+			ID:       types.StringValue(g.ID),
+			Name:     types.StringValue(g.Name),
+			Primary:  types.BoolValue(g.Primary),
+			Distance: types.Float64Value(g.Distance),
+		})
+	}
+
+	return models
+}
@@ -0,0 +1,243 @@
+package strava
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &athleteProfileResource{}
+	_ resource.ResourceWithConfigure   = &athleteProfileResource{}
+	_ resource.ResourceWithImportState = &athleteProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &athleteProfileResource{}
+	_ withRequiredScopes               = &athleteProfileResource{}
+)
+
+// NewAthleteProfileResource is a helper function to simplify the provider implementation.
+func NewAthleteProfileResource() resource.Resource {
+	return &athleteProfileResource{}
+}
+
+// athleteProfileResource is the resource implementation.
+type athleteProfileResource struct {
+	athletes athleteClients
+}
+
+// athleteProfileResourceModel maps the resource schema data.
+type athleteProfileResourceModel struct {
+	AthleteProfile types.String  `tfsdk:"athlete_profile"`
+	ID             types.Int64   `tfsdk:"id"`
+	Weight         types.Float64 `tfsdk:"weight"`
+}
+
+// Metadata returns the resource type name.
+func (r *athleteProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_athlete_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *athleteProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the profile settings of the athlete the provider acts on behalf of. " +
+			"Requires the provider refresh_token with the profile:write scope. Destroying the resource leaves the profile as is.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description: "Athlete ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"weight": schema.Float64Attribute{
+				Description: "Weight of the athlete, in kilograms.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the resource requires.
+func (r *athleteProfileResource) requiredScopes() []string {
+	return []string{"profile:write"}
+}
+
+// ModifyPlan reports missing OAuth scopes before any change is made.
+func (r *athleteProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var profile types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.athletes.validateScopes(profile, r.requiredScopes()...)...)
+}
+
+// Create a new resource
+func (r *athleteProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan athleteProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.athletes.client(plan.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the profile
+	athlete, err := client.updateAuthenticatedAthlete(ctx, plan.Weight.ValueFloat64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Strava Athlete",
+			"Could not update athlete profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int64Value(athlete.ID)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *athleteProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state athleteProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.athletes.client(state.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed profile from Strava
+	athlete, err := client.getAuthenticatedAthlete(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Strava Athlete",
+			"Could not read athlete ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	if athlete.ID != state.ID.ValueInt64() {
+		resp.Diagnostics.AddError(
+			"Unexpected Strava Athlete",
+			"The provider acts on behalf of athlete ID "+strconv.FormatInt(athlete.ID, 10)+
+				", but this profile belongs to athlete ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+".",
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.Weight = types.Float64PointerValue(athlete.Weight)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *athleteProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan athleteProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := r.athletes.client(plan.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the profile
+	athlete, err := client.updateAuthenticatedAthlete(ctx, plan.Weight.ValueFloat64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Strava Athlete",
+			"Could not update athlete profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.Int64Value(athlete.ID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the resource from state, as athlete profiles cannot be
+// deleted.
+func (r *athleteProfileResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *athleteProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
+
+func (r *athleteProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idPart, profile, hasProfile := strings.Cut(req.ID, ",")
+
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing item",
+			"Could not import item, unexpected error (ID should be the athlete ID, optionally followed by a comma and the athlete profile): "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if hasProfile {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("athlete_profile"), profile)...)
+	}
+}
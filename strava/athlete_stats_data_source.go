@@ -0,0 +1,236 @@
+package strava
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &athleteStatsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &athleteStatsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &athleteStatsDataSource{}
+	_ withRequiredScopes                      = &athleteStatsDataSource{}
+)
+
+// NewAthleteStatsDataSource is a helper function to simplify the provider implementation.
+func NewAthleteStatsDataSource() datasource.DataSource {
+	return &athleteStatsDataSource{}
+}
+
+// athleteStatsDataSource is the data source implementation.
+type athleteStatsDataSource struct {
+	athletes athleteClients
+}
+
+// athleteStatsDataSourceModel maps the data source schema data.
+type athleteStatsDataSourceModel struct {
+	AthleteProfile            types.String       `tfsdk:"athlete_profile"`
+	ID                        types.String       `tfsdk:"id"`
+	AthleteID                 types.Int64        `tfsdk:"athlete_id"`
+	BiggestRideDistance       types.Float64      `tfsdk:"biggest_ride_distance"`
+	BiggestClimbElevationGain types.Float64      `tfsdk:"biggest_climb_elevation_gain"`
+	RecentRideTotals          activityTotalModel `tfsdk:"recent_ride_totals"`
+	RecentRunTotals           activityTotalModel `tfsdk:"recent_run_totals"`
+	RecentSwimTotals          activityTotalModel `tfsdk:"recent_swim_totals"`
+	YTDRideTotals             activityTotalModel `tfsdk:"ytd_ride_totals"`
+	YTDRunTotals              activityTotalModel `tfsdk:"ytd_run_totals"`
+	YTDSwimTotals             activityTotalModel `tfsdk:"ytd_swim_totals"`
+	AllRideTotals             activityTotalModel `tfsdk:"all_ride_totals"`
+	AllRunTotals              activityTotalModel `tfsdk:"all_run_totals"`
+	AllSwimTotals             activityTotalModel `tfsdk:"all_swim_totals"`
+}
+
+// activityTotalModel maps activity total schema data.
+type activityTotalModel struct {
+	Count            types.Int64   `tfsdk:"count"`
+	Distance         types.Float64 `tfsdk:"distance"`
+	MovingTime       types.Int64   `tfsdk:"moving_time"`
+	ElapsedTime      types.Int64   `tfsdk:"elapsed_time"`
+	ElevationGain    types.Float64 `tfsdk:"elevation_gain"`
+	AchievementCount types.Int64   `tfsdk:"achievement_count"`
+}
+
+// Metadata returns the data source type name.
+func (d *athleteStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_athlete_stats"
+}
+
+// Schema defines the schema for the data source.
+func (d *athleteStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	totalAttribute := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Description: description,
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"count": schema.Int64Attribute{
+					Description: "Number of activities.",
+					Computed:    true,
+				},
+				"distance": schema.Float64Attribute{
+					Description: "Total distance, in meters.",
+					Computed:    true,
+				},
+				"moving_time": schema.Int64Attribute{
+					Description: "Total moving time, in seconds.",
+					Computed:    true,
+				},
+				"elapsed_time": schema.Int64Attribute{
+					Description: "Total elapsed time, in seconds.",
+					Computed:    true,
+				},
+				"elevation_gain": schema.Float64Attribute{
+					Description: "Total elevation gain, in meters.",
+					Computed:    true,
+				},
+				"achievement_count": schema.Int64Attribute{
+					Description: "Number of achievements. Strava only reports it for recent totals.",
+					Computed:    true,
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the recent, year-to-date and all-time activity totals of an athlete. Requires the provider refresh_token. " +
+			"Strava only returns the stats of the athlete the provider acts on behalf of.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"athlete_id": schema.Int64Attribute{
+				Description: "Athlete ID. Defaults to the athlete the provider acts on behalf of.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"biggest_ride_distance": schema.Float64Attribute{
+				Description: "Longest distance ridden, in meters.",
+				Computed:    true,
+			},
+			"biggest_climb_elevation_gain": schema.Float64Attribute{
+				Description: "Highest climb ridden, in meters.",
+				Computed:    true,
+			},
+			"recent_ride_totals": totalAttribute("Ride totals of the last four weeks."),
+			"recent_run_totals":  totalAttribute("Run totals of the last four weeks."),
+			"recent_swim_totals": totalAttribute("Swim totals of the last four weeks."),
+			"ytd_ride_totals":    totalAttribute("Ride totals of the current year."),
+			"ytd_run_totals":     totalAttribute("Run totals of the current year."),
+			"ytd_swim_totals":    totalAttribute("Swim totals of the current year."),
+			"all_ride_totals":    totalAttribute("All-time ride totals."),
+			"all_run_totals":     totalAttribute("All-time run totals."),
+			"all_swim_totals":    totalAttribute("All-time swim totals."),
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *athleteStatsDataSource) requiredScopes() []string {
+	return []string{"read"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *athleteStatsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *athleteStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config athleteStatsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(config.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	athleteID := config.AthleteID.ValueInt64()
+	if config.AthleteID.IsNull() {
+		athlete, err := client.getAuthenticatedAthlete(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Strava Athlete",
+				err.Error(),
+			)
+			return
+		}
+
+		athleteID = athlete.ID
+	}
+
+	stats, err := client.getAthleteStats(ctx, athleteID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Athlete Stats",
+			"Could not read stats of athlete ID "+strconv.FormatInt(athleteID, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := athleteStatsDataSourceModel{
+		AthleteProfile:            config.AthleteProfile,
+		ID:                        types.StringValue("placeholder"),
+		AthleteID:                 types.Int64Value(athleteID),
+		BiggestRideDistance:       types.Float64PointerValue(stats.BiggestRideDistance),
+		BiggestClimbElevationGain: types.Float64PointerValue(stats.BiggestClimbElevationGain),
+		RecentRideTotals:          newActivityTotalModel(stats.RecentRideTotals),
+		RecentRunTotals:           newActivityTotalModel(stats.RecentRunTotals),
+		RecentSwimTotals:          newActivityTotalModel(stats.RecentSwimTotals),
+		YTDRideTotals:             newActivityTotalModel(stats.YTDRideTotals),
+		YTDRunTotals:              newActivityTotalModel(stats.YTDRunTotals),
+		YTDSwimTotals:             newActivityTotalModel(stats.YTDSwimTotals),
+		AllRideTotals:             newActivityTotalModel(stats.AllRideTotals),
+		AllRunTotals:              newActivityTotalModel(stats.AllRunTotals),
+		AllSwimTotals:             newActivityTotalModel(stats.AllSwimTotals),
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *athleteStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
+
+// newActivityTotalModel maps an activity total to its schema data.
+func newActivityTotalModel(total activityTotal) activityTotalModel {
+	return activityTotalModel{
+		Count:            types.Int64Value(total.Count),
+		Distance:         types.Float64Value(total.Distance),
+		MovingTime:       types.Int64Value(total.MovingTime),
+		ElapsedTime:      types.Int64Value(total.ElapsedTime),
+		ElevationGain:    types.Float64Value(total.ElevationGain),
+		AchievementCount: types.Int64PointerValue(total.AchievementCount),
+	}
+}
@@ -0,0 +1,202 @@
+package strava
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &athleteZonesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &athleteZonesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &athleteZonesDataSource{}
+	_ withRequiredScopes                      = &athleteZonesDataSource{}
+)
+
+// NewAthleteZonesDataSource is a helper function to simplify the provider implementation.
+func NewAthleteZonesDataSource() datasource.DataSource {
+	return &athleteZonesDataSource{}
+}
+
+// athleteZonesDataSource is the data source implementation.
+type athleteZonesDataSource struct {
+	athletes athleteClients
+}
+
+// athleteZonesDataSourceModel maps the data source schema data.
+type athleteZonesDataSourceModel struct {
+	AthleteProfile types.String         `tfsdk:"athlete_profile"`
+	ID             types.String         `tfsdk:"id"`
+	HeartRate      *heartRateZonesModel `tfsdk:"heart_rate"`
+	Power          *powerZonesModel     `tfsdk:"power"`
+}
+
+// heartRateZonesModel maps heart rate zones schema data.
+type heartRateZonesModel struct {
+	CustomZones types.Bool       `tfsdk:"custom_zones"`
+	Zones       []zoneRangeModel `tfsdk:"zones"`
+}
+
+// powerZonesModel maps power zones schema data.
+type powerZonesModel struct {
+	Zones []zoneRangeModel `tfsdk:"zones"`
+}
+
+// zoneRangeModel maps zone range schema data.
+type zoneRangeModel struct {
+	Min types.Int64 `tfsdk:"min"`
+	Max types.Int64 `tfsdk:"max"`
+}
+
+// Metadata returns the data source type name.
+func (d *athleteZonesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_athlete_zones"
+}
+
+// Schema defines the schema for the data source.
+func (d *athleteZonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	zonesAttribute := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			Description: description,
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"min": schema.Int64Attribute{
+						Description: "Lower bound of the zone.",
+						Computed:    true,
+					},
+					"max": schema.Int64Attribute{
+						Description: "Upper bound of the zone, -1 for the open-ended last zone.",
+						Computed:    true,
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the heart rate and power zones of the athlete the provider acts on behalf of. " +
+			"Requires the provider refresh_token with the profile:read_all scope.",
+		Attributes: map[string]schema.Attribute{
+			"athlete_profile": schema.StringAttribute{
+				Description: athleteProfileDescription,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"heart_rate": schema.SingleNestedAttribute{
+				Description: "Heart rate zones, in beats per minute.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"custom_zones": schema.BoolAttribute{
+						Description: "Whether the athlete set custom zones rather than zones derived from their maximum heart rate.",
+						Computed:    true,
+					},
+					"zones": zonesAttribute("Heart rate zones, from lowest to highest."),
+				},
+			},
+			"power": schema.SingleNestedAttribute{
+				Description: "Power zones, in watts. Null when the athlete has no functional threshold power set.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"zones": zonesAttribute("Power zones, from lowest to highest."),
+				},
+			},
+		},
+	}
+}
+
+// requiredScopes returns the OAuth scopes the data source requires.
+func (d *athleteZonesDataSource) requiredScopes() []string {
+	return []string{"profile:read_all"}
+}
+
+// ValidateConfig reports missing OAuth scopes before the data source is read.
+func (d *athleteZonesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var profile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("athlete_profile"), &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.athletes.validateScopes(profile, d.requiredScopes()...)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *athleteZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config athleteZonesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, diags := d.athletes.client(config.AthleteProfile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zones, err := client.getAuthenticatedAthleteZones(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Athlete Zones",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state := athleteZonesDataSourceModel{
+		AthleteProfile: config.AthleteProfile,
+		ID:             types.StringValue("placeholder"),
+	}
+
+	if zones.HeartRate != nil {
+		state.HeartRate = &heartRateZonesModel{
+			CustomZones: types.BoolValue(zones.HeartRate.CustomZones),
+			Zones:       newZoneRangeModels(zones.HeartRate.Zones),
+		}
+	}
+
+	if zones.Power != nil {
+		state.Power = &powerZonesModel{
+			Zones: newZoneRangeModels(zones.Power.Zones),
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *athleteZonesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.athletes = req.ProviderData.(*stravaProviderData).athletes
+}
+
+// newZoneRangeModels maps zone ranges to their schema data.
+func newZoneRangeModels(ranges []zoneRange) []zoneRangeModel {
+	models := []zoneRangeModel{}
+	for _, r := range ranges {
+		models = append(models, zoneRangeModel{
+			Min: types.Int64Value(r.Min),
+			Max: types.Int64Value(r.Max),
+		})
+	}
+
+	return models
+}
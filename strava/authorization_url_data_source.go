@@ -0,0 +1,157 @@
+package strava
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &authorizationURLDataSource{}
+	_ datasource.DataSourceWithConfigure = &authorizationURLDataSource{}
+)
+
+// NewAuthorizationURLDataSource is a helper function to simplify the provider implementation.
+func NewAuthorizationURLDataSource() datasource.DataSource {
+	return &authorizationURLDataSource{}
+}
+
+// authorizationURLDataSource is the data source implementation.
+type authorizationURLDataSource struct {
+	oauth *oauthClient
+}
+
+// authorizationURLDataSourceModel maps the data source schema data.
+type authorizationURLDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	RedirectURI    types.String `tfsdk:"redirect_uri"`
+	Scopes         types.Set    `tfsdk:"scopes"`
+	ApprovalPrompt types.String `tfsdk:"approval_prompt"`
+	State          types.String `tfsdk:"state"`
+	URL            types.String `tfsdk:"url"`
+}
+
+// Metadata returns the data source type name.
+func (d *authorizationURLDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authorization_url"
+}
+
+// Schema defines the schema for the data source.
+func (d *authorizationURLDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Builds the URL of the Strava page where athletes grant the provider's client_id access to their data. " +
+			"Strava then redirects to redirect_uri with the authorization code for strava_oauth_token. No request is made.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the data source, the authorization URL.",
+				Computed:    true,
+			},
+			"redirect_uri": schema.StringAttribute{
+				Description: "URL Strava redirects the athlete to after authorization. Its domain must match the authorization callback domain of the application.",
+				Required:    true,
+			},
+			"scopes": schema.SetAttribute{
+				Description: "Scopes to request, any of \"" + strings.Join(stravaScopes, "\", \"") + "\". Strava defaults to \"read\".",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(stravaScopes...)),
+				},
+			},
+			"approval_prompt": schema.StringAttribute{
+				Description: "Either \"auto\" to skip the authorization page for athletes who already granted access, or \"force\" to always show it. Strava defaults to \"auto\".",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "force"),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Value returned as is in the state parameter of the redirect.",
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "Authorization URL.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *authorizationURLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state authorizationURLDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	diags = state.Scopes.ElementsAs(ctx, &scopes, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authURL := authorizationURL(
+		d.oauth.baseURL,
+		d.oauth.clientID,
+		state.RedirectURI.ValueString(),
+		scopes,
+		state.ApprovalPrompt.ValueString(),
+		state.State.ValueString(),
+	)
+
+	state.ID = types.StringValue(authURL)
+	state.URL = types.StringValue(authURL)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *authorizationURLDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.oauth = req.ProviderData.(*stravaProviderData).oauth
+}
+
+// authorizationURL returns the URL of the Strava page where athletes grant an
+// application access to their data. Empty optional parameters are omitted.
+func authorizationURL(oauthBaseURL, clientID, redirectURI string, scopes []string, approvalPrompt, state string) string {
+	q := url.Values{}
+	q.Set("client_id", clientID)
+	q.Set("redirect_uri", redirectURI)
+	q.Set("response_type", "code")
+
+	if approvalPrompt != "" {
+		q.Set("approval_prompt", approvalPrompt)
+	}
+
+	if len(scopes) > 0 {
+		sorted := append([]string(nil), scopes...)
+		sort.Strings(sorted)
+		q.Set("scope", strings.Join(sorted, ","))
+	}
+
+	if state != "" {
+		q.Set("state", state)
+	}
+
+	return oauthBaseURL + "/authorize?" + q.Encode()
+}
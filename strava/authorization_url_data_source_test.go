@@ -0,0 +1,47 @@
+package strava
+
+import (
+	"testing"
+)
+
+func TestAuthorizationURL(t *testing.T) {
+	testCases := map[string]struct {
+		scopes         []string
+		approvalPrompt string
+		state          string
+		expected       string
+	}{
+		"minimal": {
+			expected: "https://www.strava.com/oauth/authorize?client_id=5&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback%3Fenv%3Dprod&response_type=code",
+		},
+		"full": {
+			scopes:         []string{"activity:read_all", "read"},
+			approvalPrompt: "force",
+			state:          "a b&c",
+			expected: "https://www.strava.com/oauth/authorize?approval_prompt=force&client_id=5" +
+				"&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback%3Fenv%3Dprod&response_type=code" +
+				"&scope=activity%3Aread_all%2Cread&state=a+b%26c",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := authorizationURL(
+				oauthBaseURL("https://www.strava.com/api/v3"),
+				"5",
+				"https://example.com/callback?env=prod",
+				testCase.scopes,
+				testCase.approvalPrompt,
+				testCase.state,
+			)
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package strava
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string is a positive duration such as
+// "2s" or "1m30s".
+type durationValidator struct{}
+
+// positiveDuration returns a validator which ensures that a string attribute
+// is a positive duration.
+func positiveDuration() validator.String {
+	return durationValidator{}
+}
+
+// Description describes the validation in plain text formatting.
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as \"2s\""
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && d > 0 {
+		return
+	}
+
+	detail := "The value must be a positive duration such as \"2s\" or \"1m30s\", got: " + req.ConfigValue.ValueString()
+	if err != nil {
+		detail += ".\n\n" + err.Error()
+	}
+
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", detail)
+}
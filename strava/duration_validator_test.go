@@ -0,0 +1,59 @@
+package strava
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDurationValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value  types.String
+		errors bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"valid": {
+			value: types.StringValue("1m30s"),
+		},
+		"missing unit": {
+			value:  types.StringValue("2"),
+			errors: true,
+		},
+		"zero": {
+			value:  types.StringValue("0s"),
+			errors: true,
+		},
+		"negative": {
+			value:  types.StringValue("-2s"),
+			errors: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("timeout"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			positiveDuration().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.errors {
+				t.Errorf("expected errors %t, got %v", testCase.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package strava
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// httpClientSettings holds the settings the Strava API HTTP client is built
+// from.
+type httpClientSettings struct {
+	// Timeout limits each request attempt.
+	Timeout time.Duration
+	// ProxyURL overrides the proxy taken from the environment when set.
+	ProxyURL *url.URL
+	// CABundleFile and CABundlePEM hold PEM encoded certificates trusted in
+	// addition to the system certificate pool.
+	CABundleFile string
+	CABundlePEM  string
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify bool
+
+	MaxRetries       int
+	RetryMaxWait     time.Duration
+	RespectRateLimit bool
+}
+
+// newHTTPTransport builds the transport applying the proxy and TLS settings,
+// shared by the Strava API client and the requests sent to other endpoints,
+// such as the push subscription preflight check.
+func newHTTPTransport(settings httpClientSettings) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if settings.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(settings.ProxyURL)
+	}
+
+	if settings.CABundleFile != "" || settings.CABundlePEM != "" || settings.InsecureSkipVerify {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: settings.InsecureSkipVerify, // Explicitly requested in the provider configuration.
+		}
+
+		if settings.CABundleFile != "" || settings.CABundlePEM != "" {
+			pool, err := x509.SystemCertPool()
+			if err != nil || pool == nil {
+				pool = x509.NewCertPool()
+			}
+
+			if settings.CABundleFile != "" {
+				bundle, err := os.ReadFile(settings.CABundleFile)
+				if err != nil {
+					return nil, fmt.Errorf("reading ca_bundle_file: %w", err)
+				}
+
+				if !pool.AppendCertsFromPEM(bundle) {
+					return nil, fmt.Errorf("ca_bundle_file %s does not contain any PEM encoded certificate", settings.CABundleFile)
+				}
+			}
+
+			if settings.CABundlePEM != "" && !pool.AppendCertsFromPEM([]byte(settings.CABundlePEM)) {
+				return nil, errors.New("ca_bundle_pem does not contain any PEM encoded certificate")
+			}
+
+			tlsConfig.RootCAs = pool
+		}
+
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return transport, nil
+}
+
+// newHTTPClient builds the HTTP client used for all Strava API requests on top
+// of transport. Requests are logged through the strava_http subsystem of ctx.
+func newHTTPClient(ctx context.Context, transport http.RoundTripper, settings httpClientSettings) *http.Client {
+	// The retry transport enforces the request timeout itself, so that
+	// waiting between retries does not count towards it.
+	return &http.Client{
+		Transport: newRetryTransport(newLoggingTransport(ctx, transport), settings.Timeout, settings.MaxRetries, settings.RetryMaxWait, settings.RespectRateLimit),
+	}
+}
@@ -0,0 +1,167 @@
+package strava
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpLogSubsystem is the tflog subsystem HTTP traffic is logged to.
+const httpLogSubsystem = "strava_http"
+
+// redactedValue replaces sensitive values in logs.
+const redactedValue = "***"
+
+// sensitiveHTTPFields are the query parameters and body fields whose values
+// must never be logged.
+var sensitiveHTTPFields = map[string]bool{
+	"client_secret": true,
+	"verify_token":  true,
+	"access_token":  true,
+	"refresh_token": true,
+	"code":          true,
+}
+
+// loggingTransport is an http.RoundTripper that logs requests and responses
+// at TRACE level, with sensitive values redacted.
+type loggingTransport struct {
+	base http.RoundTripper
+
+	// ctx carries the provider logger. The Strava webhook client sends its
+	// requests without a context, so request contexts cannot be used.
+	ctx context.Context
+}
+
+// newLoggingTransport wraps base with request and response logging. The
+// strava_http subsystem must already be set up in ctx.
+func newLoggingTransport(ctx context.Context, base http.RoundTripper) *loggingTransport {
+	return &loggingTransport{
+		base: base,
+		ctx:  ctx,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fields := map[string]any{
+		"http_method": req.Method,
+		"http_url":    redactURL(req.URL),
+	}
+
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestBody, _ := io.ReadAll(body)
+			body.Close()
+			fields["http_request_body"] = redactBody(req.Header.Get("Content-Type"), requestBody)
+		}
+	}
+
+	tflog.SubsystemTrace(t.ctx, httpLogSubsystem, "Sending HTTP request", fields)
+
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(t.ctx, httpLogSubsystem, "HTTP request failed", fields)
+		return nil, err
+	}
+
+	fields["http_status_code"] = res.StatusCode
+
+	// A body cut short, e.g. by a timeout, is reported as such rather than
+	// handed on truncated to fail decoding.
+	responseBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(t.ctx, httpLogSubsystem, "Reading HTTP response failed", fields)
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	fields["http_response_body"] = redactBody(res.Header.Get("Content-Type"), responseBody)
+
+	tflog.SubsystemTrace(t.ctx, httpLogSubsystem, "Received HTTP response", fields)
+
+	return res, nil
+}
+
+// redactURL returns u as a string with sensitive query parameters redacted.
+func redactURL(u *url.URL) string {
+	redacted := *u
+
+	q := redacted.Query()
+	for key := range q {
+		if sensitiveHTTPFields[key] {
+			q.Set(key, redactedValue)
+		}
+	}
+	redacted.RawQuery = q.Encode()
+
+	return redacted.String()
+}
+
+// redactBody returns body as a string with sensitive JSON or form fields
+// redacted. Bodies of any other type are returned as is.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return redactedValue
+		}
+
+		for key := range values {
+			if sensitiveHTTPFields[key] {
+				values.Set(key, redactedValue)
+			}
+		}
+
+		return values.Encode()
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactJSON(value))
+	if err != nil {
+		return redactedValue
+	}
+
+	return string(redacted)
+}
+
+// redactJSON redacts sensitive fields of a decoded JSON value at any depth.
+func redactJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if sensitiveHTTPFields[key] {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redactJSON(field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+	}
+
+	return value
+}
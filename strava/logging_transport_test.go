@@ -0,0 +1,85 @@
+package strava
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://www.strava.com/api/v3/push_subscriptions?client_id=5&client_secret=secret&verify_token=STRAVA")
+
+	got := redactURL(u)
+	expected := "https://www.strava.com/api/v3/push_subscriptions?client_id=5&client_secret=%2A%2A%2A&verify_token=%2A%2A%2A"
+
+	if got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	testCases := map[string]struct {
+		contentType string
+		body        string
+		expected    string
+	}{
+		"json": {
+			contentType: "application/json",
+			body:        `{"access_token":"a","athlete":{"id":1},"refresh_token":"r","token_type":"Bearer"}`,
+			expected:    `{"access_token":"***","athlete":{"id":1},"refresh_token":"***","token_type":"Bearer"}`,
+		},
+		"nested-json": {
+			contentType: "application/json; charset=utf-8",
+			body:        `[{"tokens":{"code":"c"}}]`,
+			expected:    `[{"tokens":{"code":"***"}}]`,
+		},
+		"form": {
+			contentType: "application/x-www-form-urlencoded",
+			body:        "client_id=5&code=c&grant_type=authorization_code",
+			expected:    "client_id=5&code=%2A%2A%2A&grant_type=authorization_code",
+		},
+		"text": {
+			contentType: "text/plain",
+			body:        "Bad Request",
+			expected:    "Bad Request",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := redactBody(testCase.contentType, []byte(testCase.body))
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestLoggingTransport_TruncatedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Promise more than is sent, so that the connection is cut short.
+		w.Header().Set("Content-Length", "100")
+		_, _ = w.Write([]byte(`{"id":`))
+	}))
+	defer server.Close()
+
+	transport := newLoggingTransport(context.Background(), http.DefaultTransport)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	res, err := transport.RoundTrip(req)
+	if err == nil {
+		res.Body.Close()
+		t.Fatal("expected an error reading the truncated response")
+	}
+
+	if res != nil {
+		t.Errorf("expected no response, got status %d", res.StatusCode)
+	}
+}
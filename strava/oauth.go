@@ -0,0 +1,282 @@
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// tokenExpiryLeeway is how long before its expiry an access token is
+// refreshed, so that it does not expire while a request is in flight.
+const tokenExpiryLeeway = 5 * time.Minute
+
+// oauthBaseURL returns the base URL of the Strava OAuth endpoints for the
+// given API base URL. The OAuth endpoints live next to the /api/v3 path.
+func oauthBaseURL(baseURL string) string {
+	return strings.TrimSuffix(baseURL, "/api/v3") + "/oauth"
+}
+
+// oauthToken maps the token responses of the Strava OAuth endpoints.
+type oauthToken struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	// ExpiresAt is the expiry of the access token as a Unix timestamp.
+	ExpiresAt int64 `json:"expires_at"`
+	// Athlete is only returned when exchanging an authorization code.
+	Athlete *oauthTokenAthlete `json:"athlete,omitempty"`
+}
+
+// oauthTokenAthlete maps the athlete summary of a token response.
+type oauthTokenAthlete struct {
+	ID int64 `json:"id"`
+}
+
+// expiresWithin reports whether the access token expires within d from now.
+// Tokens with an unknown expiry are assumed valid until rejected.
+func (t oauthToken) expiresWithin(now time.Time, d time.Duration) bool {
+	if t.AccessToken == "" {
+		return true
+	}
+
+	if t.ExpiresAt == 0 {
+		return false
+	}
+
+	return !now.Add(d).Before(time.Unix(t.ExpiresAt, 0))
+}
+
+// oauthClient calls the Strava OAuth endpoints with the credentials of the
+// API application.
+type oauthClient struct {
+	httpClient   *http.Client
+	baseURL      string
+	clientID     string
+	clientSecret string
+}
+
+// exchangeAuthorizationCode exchanges a one-time authorization code for
+// tokens.
+func (c *oauthClient) exchangeAuthorizationCode(ctx context.Context, code string) (*oauthToken, error) {
+	return c.token(ctx, url.Values{
+		"grant_type": {"authorization_code"},
+		"code":       {code},
+	})
+}
+
+// refreshToken exchanges a refresh token for a new access token. Strava may
+// rotate the refresh token, so callers must store the returned one.
+func (c *oauthClient) refreshToken(ctx context.Context, refreshToken string) (*oauthToken, error) {
+	return c.token(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+// deauthorize revokes the access of the application to the athlete who
+// owns the access token, invalidating all of the athlete's tokens.
+func (c *oauthClient) deauthorize(ctx context.Context, accessToken string) error {
+	_, err := c.post(ctx, "/deauthorize", url.Values{
+		"access_token": {accessToken},
+	})
+
+	return err
+}
+
+// token calls the token endpoint with the given grant.
+func (c *oauthClient) token(ctx context.Context, form url.Values) (*oauthToken, error) {
+	form.Set("client_id", c.clientID)
+	form.Set("client_secret", c.clientSecret)
+
+	body, err := c.post(ctx, "/token", form)
+	if err != nil {
+		return nil, err
+	}
+
+	token := oauthToken{}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// post sends a form to an OAuth endpoint and returns the response body.
+func (c *oauthClient) post(ctx context.Context, path string, form url.Values) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, &apiError{StatusCode: res.StatusCode, Body: string(body)}
+	}
+
+	return body, nil
+}
+
+// tokenSource hands out access tokens of an athlete, refreshing them before
+// they expire.
+type tokenSource struct {
+	oauth *oauthClient
+	now   func() time.Time
+
+	// cache, when set, shares the tokens with other provider processes
+	// under cacheKey.
+	cache    *tokenCache
+	cacheKey string
+	// source identifies the configured refresh token in the cache.
+	source string
+
+	mu    sync.Mutex
+	token oauthToken
+}
+
+// newTokenSource returns a token source seeded with the configured tokens.
+// The access token may be empty, in which case it is fetched on first use.
+func newTokenSource(oauth *oauthClient, refreshToken, accessToken string) *tokenSource {
+	return &tokenSource{
+		oauth: oauth,
+		now:   time.Now,
+		token: oauthToken{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		},
+	}
+}
+
+// useCache makes the token source share its tokens through cache, and picks
+// up the cached tokens descending from the configured refresh token, if any.
+// Without a configured refresh token there is nothing worth caching.
+func (s *tokenSource) useCache(cache *tokenCache, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.RefreshToken == "" {
+		return nil
+	}
+
+	entry, err := cache.get(key)
+	if err != nil {
+		return err
+	}
+
+	s.cache = cache
+	s.cacheKey = key
+	s.source = tokenSourceID(s.token.RefreshToken)
+
+	if entry != nil && entry.Source == s.source {
+		s.token = entry.Token
+	}
+
+	return nil
+}
+
+// accessToken returns a valid access token, refreshing it if it is about to
+// expire.
+func (s *tokenSource) accessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.token.expiresWithin(s.now(), tokenExpiryLeeway) {
+		return s.token.AccessToken, nil
+	}
+
+	if err := s.refreshLocked(ctx, s.token.AccessToken); err != nil {
+		return "", err
+	}
+
+	return s.token.AccessToken, nil
+}
+
+// invalidate refreshes the token after Strava rejected the given access
+// token. The refresh is skipped if another request already replaced it.
+func (s *tokenSource) invalidate(ctx context.Context, rejected string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken != rejected {
+		return nil
+	}
+
+	return s.refreshLocked(ctx, rejected)
+}
+
+// refreshLocked replaces the stale access token, through the cache when one
+// is used. s.mu must be held.
+func (s *tokenSource) refreshLocked(ctx context.Context, stale string) error {
+	if s.cache == nil {
+		return s.refreshTokenLocked(ctx)
+	}
+
+	refreshed := false
+	err := s.cache.update(s.cacheKey, func(entry *tokenCacheEntry) (*tokenCacheEntry, error) {
+		if entry != nil && entry.Source == s.source {
+			// Another process may have refreshed the token in the meantime,
+			// in which case the refresh token it used is no longer valid.
+			if entry.Token.AccessToken != stale && !entry.Token.expiresWithin(s.now(), tokenExpiryLeeway) {
+				s.token = entry.Token
+				return nil, nil
+			}
+
+			s.token.RefreshToken = entry.Token.RefreshToken
+		}
+
+		if err := s.refreshTokenLocked(ctx); err != nil {
+			return nil, err
+		}
+		refreshed = true
+
+		return &tokenCacheEntry{Source: s.source, Token: s.token}, nil
+	})
+
+	// The refreshed token is valid even if it could not be cached, so the
+	// request goes on with it rather than failing.
+	if err != nil && refreshed {
+		tflog.Warn(ctx, "Unable to write Strava token cache, continuing with the refreshed token", map[string]any{"error": err.Error()})
+		return nil
+	}
+
+	return err
+}
+
+// refreshTokenLocked exchanges the refresh token for a new access token.
+// s.mu must be held.
+func (s *tokenSource) refreshTokenLocked(ctx context.Context) error {
+	if s.token.RefreshToken == "" {
+		return fmt.Errorf("the access token is missing, expired or was rejected, and no refresh token is configured")
+	}
+
+	token, err := s.oauth.refreshToken(ctx, s.token.RefreshToken)
+	if err != nil {
+		return fmt.Errorf("refreshing access token: %w", err)
+	}
+
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+
+	s.token = *token
+
+	return nil
+}
@@ -0,0 +1,350 @@
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &oauthTokenResource{}
+	_ resource.ResourceWithConfigure  = &oauthTokenResource{}
+	_ resource.ResourceWithModifyPlan = &oauthTokenResource{}
+)
+
+// NewOAuthTokenResource is a helper function to simplify the provider implementation.
+func NewOAuthTokenResource() resource.Resource {
+	return &oauthTokenResource{}
+}
+
+// oauthTokenResource is the resource implementation.
+type oauthTokenResource struct {
+	oauth *oauthClient
+}
+
+// oauthTokenResourceModel maps the resource schema data.
+type oauthTokenResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	AuthorizationCode types.String `tfsdk:"authorization_code"`
+	Scopes            types.Set    `tfsdk:"scopes"`
+	AthleteID         types.Int64  `tfsdk:"athlete_id"`
+	AccessToken       types.String `tfsdk:"access_token"`
+	RefreshToken      types.String `tfsdk:"refresh_token"`
+	ExpiresAt         types.Int64  `tfsdk:"expires_at"`
+}
+
+// Metadata returns the resource type name.
+func (r *oauthTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *oauthTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exchanges a one-time OAuth authorization code for the tokens of an athlete, using the provider's client_id and client_secret. " +
+			"Once the access token expires, the next apply refreshes it. Reads never refresh it, as Strava may rotate the refresh token and a plan does not save the rotated one. " +
+			"The application is deauthorized when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the token, the athlete ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"authorization_code": schema.StringAttribute{
+				Description: "One-time authorization code from the code parameter of the authorization redirect.",
+				Required:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				Description: "Scopes granted by the athlete, from the scope parameter of the authorization redirect. " +
+					"Strava does not return them when exchanging the code, so they are only recorded as given.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"athlete_id": schema.Int64Attribute{
+				Description: "ID of the athlete who granted access.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				Description: "Short-lived access token.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"refresh_token": schema.StringAttribute{
+				Description: "Refresh token, e.g. for the provider's refresh_token attribute. Strava may rotate it when the access token is refreshed.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.Int64Attribute{
+				Description: "Expiry of the access token as a Unix timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource
+func (r *oauthTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan oauthTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Exchange the authorization code
+	token, err := r.oauth.exchangeAuthorizationCode(ctx, plan.AuthorizationCode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Exchanging Strava Authorization Code",
+			"Could not exchange authorization code, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if token.Athlete == nil {
+		resp.Diagnostics.AddError(
+			"Error Exchanging Strava Authorization Code",
+			"Strava did not return the athlete who granted access.",
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(strconv.FormatInt(token.Athlete.ID, 10))
+	plan.AthleteID = types.Int64Value(token.Athlete.ID)
+	plan.setToken(token)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *oauthTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state oauthTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The token is deliberately not refreshed here: Strava may rotate the
+	// refresh token, and the refreshed state is not saved during a plan.
+	// ModifyPlan schedules the refresh for the next apply instead.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan plans a refresh of the tokens once the access token expires.
+func (r *oauthTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state oauthTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !state.expired() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("access_token"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("refresh_token"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.Int64Unknown())...)
+}
+
+func (r *oauthTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan oauthTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state oauthTokenResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the recorded scopes can change without a new authorization code.
+	// The tokens are kept from state by their plan modifiers, unless
+	// ModifyPlan planned to refresh them.
+	if plan.AccessToken.IsUnknown() {
+		token, err := r.oauth.refreshToken(ctx, state.RefreshToken.ValueString())
+		if err != nil {
+			if isInvalidGrant(err) {
+				resp.Diagnostics.AddError(
+					"Strava Authorization Revoked",
+					"Strava rejected the refresh token of athlete ID "+state.ID.ValueString()+", typically because the athlete revoked access. "+
+						"Authorize the application again and set the new authorization_code to replace the token.",
+				)
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Refreshing Strava Token",
+				"Could not refresh access token of athlete ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		plan.RefreshToken = state.RefreshToken
+		plan.setToken(token)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *oauthTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state oauthTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deauthorization needs a valid access token
+	accessToken := state.AccessToken.ValueString()
+	if state.expired() {
+		token, err := r.oauth.refreshToken(ctx, state.RefreshToken.ValueString())
+		if err != nil {
+			if isInvalidGrant(err) {
+				tflog.Warn(ctx, "Refresh token was revoked, skipping deauthorization", map[string]any{"athlete_id": state.AthleteID.ValueInt64()})
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Refreshing Strava Token",
+				"Could not refresh access token of athlete ID "+state.ID.ValueString()+" before deauthorization: "+err.Error(),
+			)
+			return
+		}
+
+		accessToken = token.AccessToken
+	}
+
+	err := r.oauth.deauthorize(ctx, accessToken)
+	if err != nil && !isInvalidAccessToken(err) {
+		resp.Diagnostics.AddError(
+			"Error Deauthorizing Strava Application",
+			"Could not deauthorize application for athlete ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *oauthTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.oauth = req.ProviderData.(*stravaProviderData).oauth
+}
+
+// setToken maps a token response to the model.
+func (m *oauthTokenResourceModel) setToken(token *oauthToken) {
+	m.AccessToken = types.StringValue(token.AccessToken)
+	m.ExpiresAt = types.Int64Value(token.ExpiresAt)
+	if token.RefreshToken != "" {
+		m.RefreshToken = types.StringValue(token.RefreshToken)
+	}
+}
+
+// expired reports whether the access token is expired or about to expire.
+func (m oauthTokenResourceModel) expired() bool {
+	token := oauthToken{AccessToken: m.AccessToken.ValueString(), ExpiresAt: m.ExpiresAt.ValueInt64()}
+	return token.expiresWithin(time.Now(), tokenExpiryLeeway)
+}
+
+// oauthErrorResponse maps the error responses of the Strava OAuth endpoints,
+// e.g. {"message":"Bad Request","errors":[{"resource":"RefreshToken","field":"refresh_token","code":"invalid"}]}.
+type oauthErrorResponse struct {
+	Message string `json:"message"`
+	Errors  []struct {
+		Resource string `json:"resource"`
+		Field    string `json:"field"`
+		Code     string `json:"code"`
+	} `json:"errors"`
+}
+
+// isInvalidField reports whether err is a Strava response with the given
+// status code rejecting the given field as invalid.
+func isInvalidField(err error, statusCode int, field string) bool {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != statusCode {
+		return false
+	}
+
+	var res oauthErrorResponse
+	if json.Unmarshal([]byte(apiErr.Body), &res) != nil {
+		return false
+	}
+
+	for _, e := range res.Errors {
+		if e.Field == field && e.Code == "invalid" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isInvalidGrant reports whether err means Strava no longer accepts the
+// refresh token, typically because the athlete revoked access. Other errors,
+// such as a wrong client_secret, do not mean the grant is gone.
+func isInvalidGrant(err error) bool {
+	return isInvalidField(err, http.StatusBadRequest, "refresh_token")
+}
+
+// isInvalidAccessToken reports whether err means Strava rejected the access
+// token, e.g. because the application was already deauthorized.
+func isInvalidAccessToken(err error) bool {
+	return isInvalidField(err, http.StatusUnauthorized, "access_token")
+}
@@ -0,0 +1,350 @@
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	invalidRefreshTokenBody = `{"message":"Bad Request","errors":[{"resource":"RefreshToken","field":"refresh_token","code":"invalid"}]}`
+	invalidClientSecretBody = `{"message":"Authorization Error","errors":[{"resource":"Application","field":"client_secret","code":"invalid"}]}`
+	invalidAccessTokenBody  = `{"message":"Authorization Error","errors":[{"resource":"Athlete","field":"access_token","code":"invalid"}]}`
+)
+
+// testOAuthServer fakes the Strava OAuth endpoints. Refresh tokens other than
+// "refresh" are rejected as revoked, and so are access tokens other than the
+// ones it issued.
+type testOAuthServer struct {
+	oauth         *oauthClient
+	refreshes     int32
+	deauthorized  atomic.Value
+	wrongSecret   bool
+	issuedExpires int64
+}
+
+func newTestOAuthServer(t *testing.T) *testOAuthServer {
+	t.Helper()
+
+	s := &testOAuthServer{issuedExpires: time.Now().Add(6 * time.Hour).Unix()}
+	s.deauthorized.Store("")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if s.wrongSecret || r.FormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(invalidClientSecretBody))
+			return
+		}
+
+		token := oauthToken{ExpiresAt: s.issuedExpires}
+		switch r.FormValue("grant_type") {
+		case "authorization_code":
+			if r.FormValue("code") != "code" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message":"Bad Request","errors":[{"resource":"AuthorizationCode","field":"code","code":"invalid"}]}`))
+				return
+			}
+
+			token.AccessToken = "access"
+			token.RefreshToken = "refresh"
+			token.Athlete = &oauthTokenAthlete{ID: 42}
+		case "refresh_token":
+			if r.FormValue("refresh_token") != "refresh" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(invalidRefreshTokenBody))
+				return
+			}
+
+			atomic.AddInt32(&s.refreshes, 1)
+			token.AccessToken = "refreshed"
+			token.RefreshToken = "refresh"
+		}
+
+		_ = json.NewEncoder(w).Encode(token)
+	})
+	mux.HandleFunc("/oauth/deauthorize", func(w http.ResponseWriter, r *http.Request) {
+		accessToken := r.FormValue("access_token")
+		if accessToken != "access" && accessToken != "refreshed" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(invalidAccessTokenBody))
+			return
+		}
+
+		s.deauthorized.Store(accessToken)
+		_, _ = w.Write([]byte(`{"access_token":"` + accessToken + `"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	s.oauth = &oauthClient{
+		httpClient:   server.Client(),
+		baseURL:      oauthBaseURL(server.URL + "/api/v3"),
+		clientID:     "5",
+		clientSecret: "secret",
+	}
+
+	return s
+}
+
+// oauthTokenState returns a state of the strava_oauth_token resource.
+func oauthTokenState(t *testing.T, model *oauthTokenResourceModel) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&oauthTokenResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if model != nil {
+		if diags := state.Set(ctx, model); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+	}
+
+	return state
+}
+
+// storedOAuthToken returns the model of a stored token expiring at expiresAt.
+func storedOAuthToken(refreshToken string, expiresAt int64) *oauthTokenResourceModel {
+	return &oauthTokenResourceModel{
+		ID:                types.StringValue("42"),
+		AuthorizationCode: types.StringValue("code"),
+		Scopes:            types.SetNull(types.StringType),
+		AthleteID:         types.Int64Value(42),
+		AccessToken:       types.StringValue("access"),
+		RefreshToken:      types.StringValue(refreshToken),
+		ExpiresAt:         types.Int64Value(expiresAt),
+	}
+}
+
+func TestOAuthTokenResource_Create(t *testing.T) {
+	server := newTestOAuthServer(t)
+	r := &oauthTokenResource{oauth: server.oauth}
+
+	plan := storedOAuthToken("", 0)
+	plan.ID = types.StringUnknown()
+	plan.AthleteID = types.Int64Unknown()
+	plan.AccessToken = types.StringUnknown()
+	plan.RefreshToken = types.StringUnknown()
+	plan.ExpiresAt = types.Int64Unknown()
+
+	planState := oauthTokenState(t, plan)
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw}}
+	resp := &resource.CreateResponse{State: oauthTokenState(t, nil)}
+
+	r.Create(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state oauthTokenResourceModel
+	resp.State.Get(context.Background(), &state)
+
+	if state.ID.ValueString() != "42" || state.AthleteID.ValueInt64() != 42 {
+		t.Errorf("expected athlete 42, got ID %s and athlete_id %d", state.ID.ValueString(), state.AthleteID.ValueInt64())
+	}
+
+	if state.AccessToken.ValueString() != "access" || state.RefreshToken.ValueString() != "refresh" || state.ExpiresAt.ValueInt64() != server.issuedExpires {
+		t.Errorf("unexpected tokens: %s, %s, %d", state.AccessToken.ValueString(), state.RefreshToken.ValueString(), state.ExpiresAt.ValueInt64())
+	}
+}
+
+func TestOAuthTokenResource_ReadDoesNotRefresh(t *testing.T) {
+	server := newTestOAuthServer(t)
+	r := &oauthTokenResource{oauth: server.oauth}
+
+	stored := storedOAuthToken("refresh", time.Now().Add(-time.Hour).Unix())
+	req := resource.ReadRequest{State: oauthTokenState(t, stored)}
+	resp := &resource.ReadResponse{State: req.State}
+
+	r.Read(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if server.refreshes != 0 {
+		t.Errorf("expected no refresh on read, got %d", server.refreshes)
+	}
+
+	var state oauthTokenResourceModel
+	resp.State.Get(context.Background(), &state)
+	if state.AccessToken.ValueString() != "access" {
+		t.Errorf("expected the stored access token to be kept, got %s", state.AccessToken.ValueString())
+	}
+}
+
+func TestOAuthTokenResource_Update(t *testing.T) {
+	expired := time.Now().Add(-time.Hour).Unix()
+
+	testCases := map[string]struct {
+		refreshToken string
+		wrongSecret  bool
+		errorSummary string
+	}{
+		"refreshed": {
+			refreshToken: "refresh",
+		},
+		"revoked grant": {
+			refreshToken: "revoked",
+			errorSummary: "Strava Authorization Revoked",
+		},
+		"wrong client secret": {
+			refreshToken: "refresh",
+			wrongSecret:  true,
+			errorSummary: "Error Refreshing Strava Token",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newTestOAuthServer(t)
+			server.wrongSecret = testCase.wrongSecret
+			r := &oauthTokenResource{oauth: server.oauth}
+
+			stored := storedOAuthToken(testCase.refreshToken, expired)
+			plan := *stored
+			plan.AccessToken = types.StringUnknown()
+			plan.RefreshToken = types.StringUnknown()
+			plan.ExpiresAt = types.Int64Unknown()
+
+			planState := oauthTokenState(t, &plan)
+			req := resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw},
+				State: oauthTokenState(t, stored),
+			}
+			resp := &resource.UpdateResponse{State: req.State}
+
+			r.Update(context.Background(), req, resp)
+
+			if testCase.errorSummary != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != testCase.errorSummary {
+					t.Fatalf("expected error %q, got %v", testCase.errorSummary, resp.Diagnostics)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state oauthTokenResourceModel
+			resp.State.Get(context.Background(), &state)
+
+			if state.AccessToken.ValueString() != "refreshed" || state.RefreshToken.ValueString() != "refresh" || state.ExpiresAt.ValueInt64() != server.issuedExpires {
+				t.Errorf("unexpected tokens: %s, %s, %d", state.AccessToken.ValueString(), state.RefreshToken.ValueString(), state.ExpiresAt.ValueInt64())
+			}
+		})
+	}
+}
+
+func TestOAuthTokenResource_Delete(t *testing.T) {
+	valid := time.Now().Add(time.Hour).Unix()
+	expired := time.Now().Add(-time.Hour).Unix()
+
+	testCases := map[string]struct {
+		stored       *oauthTokenResourceModel
+		wrongSecret  bool
+		deauthorized string
+		errors       bool
+	}{
+		"valid access token": {
+			stored:       storedOAuthToken("refresh", valid),
+			deauthorized: "access",
+		},
+		"expired access token": {
+			stored:       storedOAuthToken("refresh", expired),
+			deauthorized: "refreshed",
+		},
+		"revoked grant": {
+			stored: storedOAuthToken("revoked", expired),
+		},
+		"already deauthorized": {
+			stored: func() *oauthTokenResourceModel {
+				m := storedOAuthToken("refresh", valid)
+				m.AccessToken = types.StringValue("stale")
+				return m
+			}(),
+		},
+		"wrong client secret": {
+			stored:      storedOAuthToken("refresh", expired),
+			wrongSecret: true,
+			errors:      true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newTestOAuthServer(t)
+			server.wrongSecret = testCase.wrongSecret
+			r := &oauthTokenResource{oauth: server.oauth}
+
+			req := resource.DeleteRequest{State: oauthTokenState(t, testCase.stored)}
+			resp := &resource.DeleteResponse{State: req.State}
+
+			r.Delete(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.errors {
+				t.Fatalf("expected errors %t, got %v", testCase.errors, resp.Diagnostics)
+			}
+
+			if got := server.deauthorized.Load().(string); got != testCase.deauthorized {
+				t.Errorf("expected deauthorization with %q, got %q", testCase.deauthorized, got)
+			}
+		})
+	}
+}
+
+func TestIsInvalidGrant(t *testing.T) {
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"invalid refresh token": {
+			err:      &apiError{StatusCode: http.StatusBadRequest, Body: invalidRefreshTokenBody},
+			expected: true,
+		},
+		"invalid client secret": {
+			err: &apiError{StatusCode: http.StatusUnauthorized, Body: invalidClientSecretBody},
+		},
+		"other bad request": {
+			err: &apiError{StatusCode: http.StatusBadRequest, Body: `{"message":"Bad Request","errors":[{"resource":"Application","field":"client_id","code":"invalid"}]}`},
+		},
+		"unparsable body": {
+			err: &apiError{StatusCode: http.StatusBadRequest, Body: "<html>"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isInvalidGrant(testCase.err); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -2,14 +2,22 @@ package strava
 
 import (
 	"context"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/floydspace/strava-webhook-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -46,6 +54,122 @@ func (p *stravaProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"refresh_token": schema.StringAttribute{
+				Description: "OAuth refresh token of the athlete to act on behalf of, required by athlete-scoped resources and data sources. " +
+					"Access tokens are obtained and refreshed with it as needed. May also be provided via the STRAVA_REFRESH_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "OAuth access token of the athlete to act on behalf of. It is used until Strava rejects it, " +
+					"after which it is refreshed with the refresh_token. May also be provided via the STRAVA_ACCESS_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"scopes": schema.SetAttribute{
+				Description: "OAuth scopes the athlete granted, as reported in the scope parameter of the authorization redirect. " +
+					"Strava offers no way to look them up, so when set they are used to report missing scopes at plan time. " +
+					"May also be provided as a comma separated list via the STRAVA_SCOPES environment variable.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(stravaScopes...)),
+				},
+			},
+			"token_cache_path": schema.StringAttribute{
+				Description: "Path to a file where athlete tokens are cached, encrypted with a key derived from the client_secret. " +
+					"Provider processes sharing the file reuse valid access tokens and keep the refresh tokens Strava rotates, " +
+					"instead of refreshing on every run. Access to the file is serialized with a file lock.",
+				Optional: true,
+			},
+			"base_url": schema.StringAttribute{
+				Description: "Base URL of the Strava API, e.g. to go through a proxy or to use a local stand-in server. " +
+					"Defaults to " + strava.HostURL + ". May also be provided via the STRAVA_BASE_URL environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a Strava API request is retried after it was throttled or failed with a server error. " +
+					"Only idempotent requests are retried on server errors. Defaults to 3.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: "Maximum duration to wait between two retries, e.g. \"30s\". Defaults to 30 seconds.",
+				Optional:    true,
+				Validators: []validator.String{
+					positiveDuration(),
+				},
+			},
+			"respect_rate_limit": schema.BoolAttribute{
+				Description: "Whether to wait for the next 15-minute rate limit window once Strava reports the short-term request budget is used up, " +
+					"instead of failing with a rate limit error. Defaults to true.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"athlete": schema.ListNestedBlock{
+				Description: "Additional athlete to act on behalf of, selected with the athlete_profile attribute of athlete-scoped resources and data sources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the athlete profile, unique within the provider. " +
+								"An athlete named \"default\" is used when athlete_profile is not set, unless refresh_token is set.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"refresh_token": schema.StringAttribute{
+							Description: "OAuth refresh token of the athlete.",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"access_token": schema.StringAttribute{
+							Description: "OAuth access token of the athlete, used until Strava rejects it.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"scopes": schema.SetAttribute{
+							Description: "OAuth scopes the athlete granted, used to report missing scopes at plan time.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(stravaScopes...)),
+							},
+						},
+					},
+				},
+			},
+			"http": schema.SingleNestedBlock{
+				Description: "Settings of the HTTP client used to reach the Strava API.",
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.StringAttribute{
+						Description: "Maximum duration of a single request attempt, e.g. \"10s\". Defaults to 10 seconds.",
+						Optional:    true,
+						Validators: []validator.String{
+							positiveDuration(),
+						},
+					},
+					"proxy_url": schema.StringAttribute{
+						Description: "URL of the proxy to send requests through. Defaults to the proxy set by the HTTPS_PROXY and NO_PROXY environment variables.",
+						Optional:    true,
+					},
+					"ca_bundle_file": schema.StringAttribute{
+						Description: "Path to a file of PEM encoded CA certificates to trust in addition to the system ones.",
+						Optional:    true,
+					},
+					"ca_bundle_pem": schema.StringAttribute{
+						Description: "PEM encoded CA certificates to trust in addition to the system ones.",
+						Optional:    true,
+					},
+					"insecure_skip_verify": schema.BoolAttribute{
+						Description: "Whether to skip TLS certificate verification. Only meant for testing.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -83,6 +207,86 @@ func (p *stravaProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if config.RefreshToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("refresh_token"),
+			"Unknown Strava API Refresh Token",
+			"The provider cannot create the Strava API client as there is an unknown configuration value for the Strava API Refresh Token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STRAVA_REFRESH_TOKEN environment variable.",
+		)
+	}
+
+	if config.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Unknown Strava API Access Token",
+			"The provider cannot create the Strava API client as there is an unknown configuration value for the Strava API Access Token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STRAVA_ACCESS_TOKEN environment variable.",
+		)
+	}
+
+	if config.Scopes.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scopes"),
+			"Unknown Strava API Scopes",
+			"The provider cannot create the Strava API client as there is an unknown configuration value for the Strava API Scopes. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STRAVA_SCOPES environment variable.",
+		)
+	}
+
+	if config.TokenCachePath.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_cache_path"),
+			"Unknown Strava Token Cache Path",
+			"The provider cannot create the Strava API client as there is an unknown configuration value for the Strava token cache path. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.BaseURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Unknown Strava API Base URL",
+			"The provider cannot create the Strava API client as there is an unknown configuration value for the Strava API Base URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STRAVA_BASE_URL environment variable.",
+		)
+	}
+
+	if config.HTTP != nil {
+		httpValues := []struct {
+			name    string
+			unknown bool
+		}{
+			{"timeout", config.HTTP.Timeout.IsUnknown()},
+			{"proxy_url", config.HTTP.ProxyURL.IsUnknown()},
+			{"ca_bundle_file", config.HTTP.CABundleFile.IsUnknown()},
+			{"ca_bundle_pem", config.HTTP.CABundlePEM.IsUnknown()},
+			{"insecure_skip_verify", config.HTTP.InsecureSkipVerify.IsUnknown()},
+		}
+
+		for _, value := range httpValues {
+			if value.unknown {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("http").AtName(value.name),
+					"Unknown Strava API HTTP Setting",
+					"The provider cannot create the Strava API client as there is an unknown configuration value for the http "+value.name+" setting. "+
+						"Either target apply the source of the value first or set the value statically in the configuration.",
+				)
+			}
+		}
+	}
+
+	for i, athlete := range config.Athletes {
+		if athlete.Name.IsUnknown() || athlete.RefreshToken.IsUnknown() || athlete.AccessToken.IsUnknown() || athlete.Scopes.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("athlete").AtListIndex(i),
+				"Unknown Strava Athlete",
+				"The provider cannot create the Strava API client as there is an unknown configuration value for an athlete block. "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -101,6 +305,44 @@ func (p *stravaProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		clientSecret = config.ClientSecret.ValueString()
 	}
 
+	refreshToken := os.Getenv("STRAVA_REFRESH_TOKEN")
+	if !config.RefreshToken.IsNull() {
+		refreshToken = config.RefreshToken.ValueString()
+	}
+
+	accessToken := os.Getenv("STRAVA_ACCESS_TOKEN")
+	if !config.AccessToken.IsNull() {
+		accessToken = config.AccessToken.ValueString()
+	}
+
+	var scopes []string
+	if v := os.Getenv("STRAVA_SCOPES"); v != "" {
+		scopes = parseScopes(v)
+
+		if unknown := unknownScopes(scopes); len(unknown) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("scopes"),
+				"Invalid Strava API Scopes",
+				"The STRAVA_SCOPES environment variable contains unknown scopes: "+strings.Join(unknown, ", ")+". "+
+					"Valid scopes are: "+strings.Join(stravaScopes, ", ")+".",
+			)
+		}
+	}
+
+	if !config.Scopes.IsNull() {
+		resp.Diagnostics.Append(config.Scopes.ElementsAs(ctx, &scopes, false)...)
+	}
+
+	baseURL := os.Getenv("STRAVA_BASE_URL")
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
+	}
+
+	if baseURL == "" {
+		baseURL = strava.HostURL
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -124,18 +366,113 @@ func (p *stravaProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if u, err := url.Parse(baseURL); err != nil || u.Scheme == "" || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Invalid Strava API Base URL",
+			"The provider cannot create the Strava API client as the Strava API Base URL "+baseURL+" is not an absolute URL. "+
+				"Set the base_url value in the configuration or use the STRAVA_BASE_URL environment variable.",
+		)
+	}
+
+	athleteNames := map[string]bool{}
+	if refreshToken != "" || accessToken != "" {
+		athleteNames[defaultAthleteProfile] = true
+	}
+
+	for i, athlete := range config.Athletes {
+		name := athlete.Name.ValueString()
+		if athleteNames[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("athlete").AtListIndex(i).AtName("name"),
+				"Duplicate Strava Athlete Profile",
+				"The athlete profile name "+name+" is already used. "+
+					"The name "+defaultAthleteProfile+" is reserved for the athlete set with the refresh_token attribute, if any.",
+			)
+		}
+		athleteNames[name] = true
+	}
+
+	httpSettings := httpClientSettings{
+		Timeout:          defaultHTTPTimeout,
+		MaxRetries:       defaultMaxRetries,
+		RetryMaxWait:     defaultRetryMaxWait,
+		RespectRateLimit: true,
+	}
+
+	if !config.MaxRetries.IsNull() {
+		httpSettings.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	if !config.RetryMaxWait.IsNull() {
+		var err error
+		httpSettings.RetryMaxWait, err = time.ParseDuration(config.RetryMaxWait.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				"The retry_max_wait value must be a duration such as \"30s\": "+err.Error(),
+			)
+		}
+	}
+
+	if !config.RespectRateLimit.IsNull() {
+		httpSettings.RespectRateLimit = config.RespectRateLimit.ValueBool()
+	}
+
+	if config.HTTP != nil {
+		if !config.HTTP.Timeout.IsNull() {
+			var err error
+			httpSettings.Timeout, err = time.ParseDuration(config.HTTP.Timeout.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("http").AtName("timeout"),
+					"Invalid HTTP Timeout",
+					"The timeout value must be a duration such as \"10s\": "+err.Error(),
+				)
+			}
+		}
+
+		if !config.HTTP.ProxyURL.IsNull() {
+			proxyURL, err := url.Parse(config.HTTP.ProxyURL.ValueString())
+			if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("http").AtName("proxy_url"),
+					"Invalid HTTP Proxy URL",
+					"The proxy_url value must be an absolute URL such as \"http://proxy.example.com:3128\".",
+				)
+			}
+			httpSettings.ProxyURL = proxyURL
+		}
+
+		httpSettings.CABundleFile = config.HTTP.CABundleFile.ValueString()
+		httpSettings.CABundlePEM = config.HTTP.CABundlePEM.ValueString()
+		httpSettings.InsecureSkipVerify = config.HTTP.InsecureSkipVerify.ValueBool()
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	ctx = tflog.SetField(ctx, "strava_client_id", clientId)
 	ctx = tflog.SetField(ctx, "strava_client_secret", clientSecret)
+	ctx = tflog.SetField(ctx, "strava_base_url", baseURL)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "strava_client_secret")
 
+	// Log HTTP traffic in its own subsystem, on top of which the HTTP client
+	// redacts tokens and secrets found in URLs and bodies.
+	ctx = tflog.NewSubsystem(ctx, httpLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_STRAVA_HTTP"))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, httpLogSubsystem, "strava_client_secret")
+	ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, httpLogSubsystem, clientSecret)
+	ctx = maskTokens(ctx, refreshToken, accessToken)
+	for _, athlete := range config.Athletes {
+		ctx = maskTokens(ctx, athlete.RefreshToken.ValueString(), athlete.AccessToken.ValueString())
+	}
+
 	tflog.Debug(ctx, "Creating Strava client")
 
 	// Create a new Strava client using the configuration values
-	client, err := strava.NewClient(nil, &clientId, &clientSecret)
+	client, err := strava.NewClient(&baseURL, &clientId, &clientSecret)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Strava API Client",
@@ -146,10 +483,70 @@ func (p *stravaProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Make the Strava client available during DataSource and Resource
+	transport, err := newHTTPTransport(httpSettings)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("http"),
+			"Unable to Create Strava API HTTP Client",
+			"An unexpected error occurred when creating the HTTP client of the Strava API client: "+err.Error(),
+		)
+		return
+	}
+	client.HTTPClient = newHTTPClient(ctx, transport, httpSettings)
+
+	providerData := &stravaProviderData{
+		client:    client,
+		transport: transport,
+		oauth: &oauthClient{
+			httpClient:   client.HTTPClient,
+			baseURL:      oauthBaseURL(baseURL),
+			clientID:     clientId,
+			clientSecret: clientSecret,
+		},
+		athletes: athleteClients{},
+	}
+
+	// Athlete-scoped APIs are only available with athlete tokens
+	newAthleteClient := func(name, refreshToken, accessToken string, scopes []string) *apiClient {
+		tokens := newTokenSource(providerData.oauth, refreshToken, accessToken)
+
+		if !config.TokenCachePath.IsNull() {
+			cache := newTokenCache(config.TokenCachePath.ValueString(), clientSecret)
+			if err := tokens.useCache(cache, tokenCacheKey(clientId, refreshToken)); err != nil {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("token_cache_path"),
+					"Unable to Use Strava Token Cache",
+					"The token cache could not be read, so tokens of athlete profile "+name+" will not be shared with other provider processes: "+err.Error(),
+				)
+			}
+		}
+
+		return &apiClient{
+			httpClient: client.HTTPClient,
+			baseURL:    baseURL,
+			tokens:     tokens,
+			scopes:     scopes,
+		}
+	}
+
+	if refreshToken != "" || accessToken != "" {
+		providerData.athletes[defaultAthleteProfile] = newAthleteClient(defaultAthleteProfile, refreshToken, accessToken, scopes)
+	}
+
+	for _, athlete := range config.Athletes {
+		var athleteScopes []string
+		if !athlete.Scopes.IsNull() {
+			resp.Diagnostics.Append(athlete.Scopes.ElementsAs(ctx, &athleteScopes, false)...)
+		}
+
+		name := athlete.Name.ValueString()
+		providerData.athletes[name] = newAthleteClient(name, athlete.RefreshToken.ValueString(), athlete.AccessToken.ValueString(), athleteScopes)
+	}
+
+	// Make the Strava clients available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 
 	tflog.Info(ctx, "Configured Strava client", map[string]any{"success": true})
 }
@@ -157,6 +554,18 @@ func (p *stravaProvider) Configure(ctx context.Context, req provider.ConfigureRe
 // DataSources defines the data sources implemented in the provider.
 func (p *stravaProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewActivitiesDataSource,
+		NewActivityCommentsDataSource,
+		NewActivityDataSource,
+		NewActivityKudoersDataSource,
+		NewActivityLapsDataSource,
+		NewActivityStreamsDataSource,
+		NewActivityZonesDataSource,
+		NewAthleteDataSource,
+		NewAthleteStatsDataSource,
+		NewAthleteZonesDataSource,
+		NewAuthorizationURLDataSource,
+		NewPushSubscriptionDataSource,
 		NewPushSubscriptionsDataSource,
 	}
 }
@@ -164,12 +573,75 @@ func (p *stravaProvider) DataSources(_ context.Context) []func() datasource.Data
 // Resources defines the resources implemented in the provider.
 func (p *stravaProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewActivityResource,
+		NewAthleteProfileResource,
+		NewOAuthTokenResource,
 		NewPushSubscriptionResource,
 	}
 }
 
 // stravaProviderModel maps provider schema data to a Go type.
 type stravaProviderModel struct {
-	ClientId     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
+	ClientId         types.String `tfsdk:"client_id"`
+	ClientSecret     types.String `tfsdk:"client_secret"`
+	RefreshToken     types.String `tfsdk:"refresh_token"`
+	AccessToken      types.String `tfsdk:"access_token"`
+	Scopes           types.Set    `tfsdk:"scopes"`
+	TokenCachePath   types.String `tfsdk:"token_cache_path"`
+	BaseURL          types.String `tfsdk:"base_url"`
+	MaxRetries       types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait     types.String `tfsdk:"retry_max_wait"`
+	RespectRateLimit types.Bool   `tfsdk:"respect_rate_limit"`
+
+	Athletes []stravaProviderAthleteModel `tfsdk:"athlete"`
+	HTTP     *stravaProviderHTTPModel     `tfsdk:"http"`
+}
+
+// defaultAthleteProfile names the athlete whose tokens are set with the
+// refresh_token and access_token provider attributes.
+const defaultAthleteProfile = "default"
+
+// stravaProviderData holds the Strava clients shared with data sources and
+// resources.
+type stravaProviderData struct {
+	// client calls the push subscription API with the application credentials.
+	client *strava.Client
+	// oauth calls the OAuth endpoints with the application credentials.
+	oauth *oauthClient
+	// athletes call athlete-scoped APIs, keyed by athlete profile name.
+	athletes athleteClients
+	// transport applies the http block settings to requests sent outside the
+	// Strava API, such as the push subscription preflight check.
+	transport http.RoundTripper
+}
+
+// maskTokens masks the given tokens in all logs, including HTTP logs.
+func maskTokens(ctx context.Context, tokens ...string) context.Context {
+	for _, token := range tokens {
+		if token == "" {
+			continue
+		}
+
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, token)
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, httpLogSubsystem, token)
+	}
+
+	return ctx
+}
+
+// stravaProviderAthleteModel maps the athlete block schema data.
+type stravaProviderAthleteModel struct {
+	Name         types.String `tfsdk:"name"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	Scopes       types.Set    `tfsdk:"scopes"`
+}
+
+// stravaProviderHTTPModel maps the http block schema data.
+type stravaProviderHTTPModel struct {
+	Timeout            types.String `tfsdk:"timeout"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	CABundleFile       types.String `tfsdk:"ca_bundle_file"`
+	CABundlePEM        types.String `tfsdk:"ca_bundle_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
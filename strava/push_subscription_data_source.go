@@ -0,0 +1,155 @@
+package strava
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/floydspace/strava-webhook-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &pushSubscriptionDataSource{}
+	_ datasource.DataSourceWithConfigure        = &pushSubscriptionDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &pushSubscriptionDataSource{}
+)
+
+// NewPushSubscriptionDataSource is a helper function to simplify the provider implementation.
+func NewPushSubscriptionDataSource() datasource.DataSource {
+	return &pushSubscriptionDataSource{}
+}
+
+// pushSubscriptionDataSource is the data source implementation.
+type pushSubscriptionDataSource struct {
+	client *strava.Client
+}
+
+// pushSubscriptionDataSourceModel maps the data source schema data.
+type pushSubscriptionDataSourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	ResourceState types.Int64  `tfsdk:"resource_state"`
+	ApplicationID types.Int64  `tfsdk:"application_id"`
+	CallbackURL   types.String `tfsdk:"callback_url"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *pushSubscriptionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_push_subscription"
+}
+
+// Schema defines the schema for the data source.
+func (d *pushSubscriptionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single push subscription by its ID or callback URL.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Push subscription ID. Exactly one of id or callback_url must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"resource_state": schema.Int64Attribute{
+				Description: "State of the subscription.",
+				Computed:    true,
+			},
+			"application_id": schema.Int64Attribute{
+				Description: "Strava API application ID.",
+				Computed:    true,
+			},
+			"callback_url": schema.StringAttribute{
+				Description: "Address where webhook events will be sent. Exactly one of id or callback_url must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date and time the subscription was created.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Date and time the subscription was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators returns the validators for the data source configuration.
+func (d *pushSubscriptionDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("callback_url"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pushSubscriptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pushSubscriptionDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pushSubscriptions, err := findPushSubscriptions(d.client, int(state.ID.ValueInt64()), state.CallbackURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Strava Subscriptions",
+			err.Error(),
+		)
+		return
+	}
+
+	criteria := fmt.Sprintf("callback URL %q", state.CallbackURL.ValueString())
+	if !state.ID.IsNull() {
+		criteria = fmt.Sprintf("ID %d", state.ID.ValueInt64())
+	}
+
+	if len(pushSubscriptions) == 0 {
+		resp.Diagnostics.AddError(
+			"Strava Subscription Not Found",
+			"No push subscription matches "+criteria+".",
+		)
+		return
+	}
+
+	if len(pushSubscriptions) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Strava Subscriptions Found",
+			fmt.Sprintf("%d push subscriptions match %s, expected exactly one.", len(pushSubscriptions), criteria),
+		)
+		return
+	}
+
+	// Map response body to model
+	subscription := pushSubscriptions[0]
+	state.ID = types.Int64Value(int64(subscription.ID))
+	state.ResourceState = types.Int64Value(int64(subscription.ResourceState))
+	state.ApplicationID = types.Int64Value(int64(subscription.ApplicationID))
+	state.CallbackURL = types.StringValue(subscription.CallbackURL)
+	state.CreatedAt = types.StringValue(subscription.CreatedAt)
+	state.UpdatedAt = types.StringValue(subscription.UpdatedAt)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pushSubscriptionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*stravaProviderData).client
+}
@@ -0,0 +1,36 @@
+package strava
+
+import (
+	"github.com/floydspace/strava-webhook-client-go"
+)
+
+// filterPushSubscriptions returns the subscriptions matching the given ID and
+// callback URL. A zero ID or an empty callback URL matches any subscription.
+func filterPushSubscriptions(subscriptions []strava.Subscription, id int, callbackURL string) []strava.Subscription {
+	var matches []strava.Subscription
+
+	for _, subscription := range subscriptions {
+		if id != 0 && subscription.ID != id {
+			continue
+		}
+
+		if callbackURL != "" && subscription.CallbackURL != callbackURL {
+			continue
+		}
+
+		matches = append(matches, subscription)
+	}
+
+	return matches
+}
+
+// findPushSubscriptions fetches all push subscriptions of the application and
+// returns the ones matching the given ID and callback URL.
+func findPushSubscriptions(client *strava.Client, id int, callbackURL string) ([]strava.Subscription, error) {
+	subscriptions, err := client.GetAllSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterPushSubscriptions(*subscriptions, id, callbackURL), nil
+}
@@ -0,0 +1,61 @@
+package strava
+
+import (
+	"testing"
+
+	"github.com/floydspace/strava-webhook-client-go"
+)
+
+func TestFilterPushSubscriptions(t *testing.T) {
+	subscriptions := []strava.Subscription{
+		{ID: 1, CallbackURL: "https://example.com/one"},
+		{ID: 2, CallbackURL: "https://example.com/two"},
+	}
+
+	testCases := map[string]struct {
+		id          int
+		callbackURL string
+		expected    []int
+	}{
+		"by id": {
+			id:       2,
+			expected: []int{2},
+		},
+		"by callback url": {
+			callbackURL: "https://example.com/one",
+			expected:    []int{1},
+		},
+		"by id and callback url": {
+			id:          1,
+			callbackURL: "https://example.com/one",
+			expected:    []int{1},
+		},
+		"no match": {
+			id:          1,
+			callbackURL: "https://example.com/two",
+		},
+		"no filter": {
+			expected: []int{1, 2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			matches := filterPushSubscriptions(subscriptions, testCase.id, testCase.callbackURL)
+
+			if len(matches) != len(testCase.expected) {
+				t.Fatalf("expected %d matches, got %d", len(testCase.expected), len(matches))
+			}
+
+			for i, match := range matches {
+				if match.ID != testCase.expected[i] {
+					t.Errorf("expected subscription %d at index %d, got %d", testCase.expected[i], i, match.ID)
+				}
+			}
+		})
+	}
+}
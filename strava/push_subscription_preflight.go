@@ -0,0 +1,111 @@
+package strava
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// defaultPreflightTimeout mirrors the time Strava gives a callback endpoint to
+// answer the subscription validation request.
+const defaultPreflightTimeout = 2 * time.Second
+
+// maxPreflightBodySize limits how much of the callback response is read and
+// reported back in diagnostics.
+const maxPreflightBodySize = 1024
+
+// callbackPreflightResult describes the callback response observed during a
+// preflight handshake check.
+type callbackPreflightResult struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+}
+
+// String formats the result for use in diagnostics.
+func (r callbackPreflightResult) String() string {
+	return fmt.Sprintf("Status code: %d\nResponse body: %s\nElapsed: %s", r.StatusCode, r.Body, r.Duration.Round(time.Millisecond))
+}
+
+// checkCallbackHandshake sends the same validation request Strava sends to a
+// callback URL when a subscription is created, and verifies that the endpoint
+// echoes the challenge back as JSON.
+//
+// The result is returned whenever a response was received, even if the
+// handshake failed, so that callers can report it.
+func checkCallbackHandshake(ctx context.Context, httpClient *http.Client, callbackURL, verifyToken string) (*callbackPreflightResult, error) {
+	challenge, err := newPreflightChallenge()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid callback URL: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("hub.mode", "subscribe")
+	q.Set("hub.challenge", challenge)
+	q.Set("hub.verify_token", verifyToken)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed after %s: %w", time.Since(start).Round(time.Millisecond), err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxPreflightBodySize))
+	result := &callbackPreflightResult{
+		StatusCode: res.StatusCode,
+		Body:       string(body),
+		Duration:   time.Since(start),
+	}
+	if err != nil {
+		return result, fmt.Errorf("reading response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return result, fmt.Errorf("expected status code %d, got %d", http.StatusOK, res.StatusCode)
+	}
+
+	var echo struct {
+		Challenge *string `json:"hub.challenge"`
+	}
+	if err := json.Unmarshal(body, &echo); err != nil {
+		return result, fmt.Errorf("response body is not valid JSON: %w", err)
+	}
+
+	if echo.Challenge == nil {
+		return result, fmt.Errorf("response body does not contain the hub.challenge field")
+	}
+
+	if *echo.Challenge != challenge {
+		return result, fmt.Errorf("expected hub.challenge %q, got %q", challenge, *echo.Challenge)
+	}
+
+	return result, nil
+}
+
+// newPreflightChallenge returns a random challenge string.
+func newPreflightChallenge() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,92 @@
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCheckCallbackHandshake(t *testing.T) {
+	testCases := map[string]struct {
+		handler     http.HandlerFunc
+		expectedErr string
+	}{
+		"valid": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("hub.mode") != "subscribe" || r.URL.Query().Get("hub.verify_token") != "STRAVA" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]string{"hub.challenge": r.URL.Query().Get("hub.challenge")})
+			},
+		},
+		"wrong-status": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			},
+			expectedErr: "expected status code 200, got 403",
+		},
+		"not-json": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(r.URL.Query().Get("hub.challenge")))
+			},
+			expectedErr: "response body is not valid JSON",
+		},
+		"missing-challenge": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			},
+			expectedErr: "does not contain the hub.challenge field",
+		},
+		"wrong-challenge": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"hub.challenge":"nope"}`))
+			},
+			expectedErr: `got "nope"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(testCase.handler)
+			defer server.Close()
+
+			result, err := checkCallbackHandshake(context.Background(), server.Client(), server.URL+"/webhook", "STRAVA")
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Fatalf("expected error containing %q, got: %v", testCase.expectedErr, err)
+			}
+
+			if result == nil {
+				t.Fatal("expected a result to be reported")
+			}
+		})
+	}
+}
+
+func TestCheckCallbackHandshake_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	result, err := checkCallbackHandshake(context.Background(), server.Client(), server.URL, "STRAVA")
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if result != nil {
+		t.Fatalf("expected no result, got: %v", result)
+	}
+}
@@ -2,18 +2,25 @@ package strava
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/floydspace/strava-webhook-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -23,6 +30,13 @@ var (
 	_ resource.ResourceWithImportState = &pushSubscriptionResource{}
 )
 
+// Policies for an existing subscription when creating a new one.
+const (
+	onConflictFail    = "fail"
+	onConflictAdopt   = "adopt"
+	onConflictReplace = "replace"
+)
+
 // NewPushSubscriptionResource is a helper function to simplify the provider implementation.
 func NewPushSubscriptionResource() resource.Resource {
 	return &pushSubscriptionResource{}
@@ -30,7 +44,8 @@ func NewPushSubscriptionResource() resource.Resource {
 
 // pushSubscriptionResource is the resource implementation.
 type pushSubscriptionResource struct {
-	client *strava.Client
+	client    *strava.Client
+	transport http.RoundTripper
 }
 
 // pushSubscriptionResourceModel maps the resource schema data.
@@ -43,6 +58,14 @@ type pushSubscriptionResourceModel struct {
 	VerifyToken   types.String `tfsdk:"verify_token"`
 	CreatedAt     types.String `tfsdk:"created_at"`
 	UpdatedAt     types.String `tfsdk:"updated_at"`
+	OnConflict    types.String `tfsdk:"on_conflict"`
+
+	PreflightCheck *pushSubscriptionPreflightCheckModel `tfsdk:"preflight_check"`
+}
+
+// pushSubscriptionPreflightCheckModel maps the preflight_check block schema data.
+type pushSubscriptionPreflightCheckModel struct {
+	Timeout types.String `tfsdk:"timeout"`
 }
 
 // Metadata returns the resource type name.
@@ -86,7 +109,22 @@ func (r *pushSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRe
 				Required:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceUnlessImported,
+						"Changing the verify token replaces the subscription, unless it was imported without one.",
+						"Changing the verify token replaces the subscription, unless it was imported without one.",
+					),
+				},
+			},
+			"on_conflict": schema.StringAttribute{
+				Description: "What to do when the application already has a push subscription, since Strava allows only one per application. " +
+					"One of \"fail\" (default), \"adopt\" to take over an existing subscription with the same callback URL, " +
+					"or \"replace\" to delete the existing subscription before creating this one.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(onConflictFail),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onConflictFail, onConflictAdopt, onConflictReplace),
 				},
 			},
 			"created_at": schema.StringAttribute{
@@ -98,6 +136,21 @@ func (r *pushSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"preflight_check": schema.SingleNestedBlock{
+				Description: "When present, the callback endpoint is sent the same validation request Strava sends, " +
+					"and must echo the challenge back as JSON before the subscription is created.",
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.StringAttribute{
+						Description: "Maximum duration to wait for the callback endpoint to respond, e.g. \"2s\". Defaults to 2 seconds, the time Strava allows.",
+						Optional:    true,
+						Validators: []validator.String{
+							positiveDuration(),
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -111,28 +164,45 @@ func (r *pushSubscriptionResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	// Create new subscription
-	pushSubscription, err := r.client.CreateSubscription(strava.SubscriptionItem{
-		CallbackURL: plan.CallbackURL.ValueString(),
-		VerifyToken: plan.VerifyToken.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating subscription",
-			"Could not create subscription, unexpected error: "+err.Error(),
-		)
-		return
+	// Verify the callback endpoint before Strava does
+	if plan.PreflightCheck != nil {
+		resp.Diagnostics.Append(r.preflightCheck(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	pushSubscription, err = r.client.GetSubscription(int(pushSubscription.ID))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading created subscription",
-			"Could not read created subscription, unexpected error: "+err.Error(),
-		)
+	// Deal with a subscription the application may already have
+	pushSubscription, diags := r.resolveConflict(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if pushSubscription == nil {
+		// Create new subscription
+		createdSubscription, err := r.client.CreateSubscription(strava.SubscriptionItem{
+			CallbackURL: plan.CallbackURL.ValueString(),
+			VerifyToken: plan.VerifyToken.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating subscription",
+				"Could not create subscription, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		pushSubscription, err = r.client.GetSubscription(int(createdSubscription.ID))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading created subscription",
+				"Could not read created subscription, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int64Value(int64(pushSubscription.ID))
 	plan.ResourceState = types.Int64Value(int64(pushSubscription.ResourceState))
@@ -160,16 +230,26 @@ func (r *pushSubscriptionResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	// Get refreshed push subscription value from Strava
-	pushSubscription, err := r.client.GetSubscription(int(state.ID.ValueInt64()))
+	// Get refreshed push subscription value from Strava. The subscriptions are
+	// listed rather than fetched one by one, so that a subscription deleted
+	// outside of Terraform can be told apart from a failed request.
+	pushSubscriptions, err := findPushSubscriptions(r.client, int(state.ID.ValueInt64()), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Strava Subscription",
-			"Could not read Strava subscription ID "+string(rune(state.ID.ValueInt64()))+": "+err.Error(),
+			"Could not read Strava subscription ID "+strconv.FormatInt(state.ID.ValueInt64(), 10)+": "+err.Error(),
 		)
 		return
 	}
 
+	if len(pushSubscriptions) == 0 {
+		tflog.Warn(ctx, "Push subscription no longer exists, removing from state", map[string]any{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	pushSubscription := pushSubscriptions[0]
+
 	// Overwrite items with refreshed state
 	state.ResourceState = types.Int64Value(int64(pushSubscription.ResourceState))
 	state.ApplicationID = types.Int64Value(int64(pushSubscription.ApplicationID))
@@ -194,45 +274,23 @@ func (r *pushSubscriptionResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	// Delete existing subscription
-	err := r.client.DeleteSubscription(int(plan.ID.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting Strava Subscription",
-			"Could not delete subscription, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	// Create new subscription
-	pushSubscription, err := r.client.CreateSubscription(strava.SubscriptionItem{
-		CallbackURL: plan.CallbackURL.ValueString(),
-		VerifyToken: plan.VerifyToken.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating subscription",
-			"Could not create subscription, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	pushSubscription, err = r.client.GetSubscription(int(pushSubscription.ID))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading created subscription",
-			"Could not read created subscription, unexpected error: "+err.Error(),
-		)
+	// Retrieve values from state
+	var state pushSubscriptionResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Update resource state with updated items and timestamp
-	plan.ID = types.Int64Value(int64(pushSubscription.ID))
-	plan.ResourceState = types.Int64Value(int64(pushSubscription.ResourceState))
-	plan.ApplicationID = types.Int64Value(int64(pushSubscription.ApplicationID))
-	plan.CallbackURL = types.StringValue(pushSubscription.CallbackURL)
-	plan.CreatedAt = types.StringValue(pushSubscription.CreatedAt)
-	plan.UpdatedAt = types.StringValue(pushSubscription.UpdatedAt)
+	// Every attribute Strava knows about requires replacement, so an update
+	// only ever changes provider-side settings, or records the verify token
+	// of a subscription imported without one, and the subscription is kept.
+	plan.ID = state.ID
+	plan.ResourceState = state.ResourceState
+	plan.ApplicationID = state.ApplicationID
+	plan.CallbackURL = state.CallbackURL
+	plan.CreatedAt = state.CreatedAt
+	plan.UpdatedAt = state.UpdatedAt
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
 	diags = resp.State.Set(ctx, plan)
@@ -268,31 +326,185 @@ func (r *pushSubscriptionResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	r.client = req.ProviderData.(*strava.Client)
+	r.client = req.ProviderData.(*stravaProviderData).client
+	r.transport = req.ProviderData.(*stravaProviderData).transport
 }
 
+// ImportState imports a push subscription by one of the following IDs:
+// "<id>,<verify_token>", "<id>" or "callback_url=<url>".
 func (r *pushSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ",")
+	var id int64
+	var verifyToken *string
+
+	if strings.HasPrefix(req.ID, "callback_url=") {
+		callbackURL := strings.TrimPrefix(req.ID, "callback_url=")
+		pushSubscriptions, err := findPushSubscriptions(r.client, 0, callbackURL)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing item",
+				"Could not look up subscription by callback URL, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		if len(pushSubscriptions) != 1 {
+			resp.Diagnostics.AddError(
+				"Error importing item",
+				fmt.Sprintf("Could not import item, expected exactly one subscription with callback URL %q, found %d.", callbackURL, len(pushSubscriptions)),
+			)
+			return
+		}
+
+		id = int64(pushSubscriptions[0].ID)
+	} else {
+		idPart, tokenPart, hasToken := strings.Cut(req.ID, ",")
+
+		var err error
+		id, err = strconv.ParseInt(idPart, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing item",
+				"Could not import item, unexpected error (ID should be in the format <id>,<verify_token>, <id> or callback_url=<url>, "+
+					"and the <id> part should be an integer): "+err.Error(),
+			)
+			return
+		}
+
+		if hasToken {
+			verifyToken = &tokenPart
+		}
+	}
 
-	if len(parts) < 2 {
-		resp.Diagnostics.AddError(
-			"Error importing item",
-			"Could not import item, unexpected error (ID should be in the format <id>,<verify_token>): "+req.ID,
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	if verifyToken == nil {
+		resp.Diagnostics.AddWarning(
+			"Verify token not imported",
+			"Strava does not expose the verify token of a subscription, so verify_token was left unset. "+
+				"The next apply records the verify_token in config without replacing the subscription, as with on_conflict = \"adopt\", "+
+				"so make sure the token in config matches the one the subscription was created with. "+
+				"Later changes to verify_token replace the subscription. "+
+				"Use the <id>,<verify_token> format to import the token explicitly.",
 		)
 		return
 	}
 
-	id, err := strconv.ParseInt(parts[0], 10, 64)
-	verifyToken := parts[1]
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verify_token"), *verifyToken)...)
+}
+
+// requiresReplaceUnlessImported requires replacement when the verify token
+// changes, except when state has none, as after importing a subscription
+// without it. The configured token is then recorded by an in-place update.
+func requiresReplaceUnlessImported(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// resolveConflict applies the on_conflict policy to the push subscriptions the
+// application already has. It returns the adopted subscription, if any, in
+// which case no new subscription must be created.
+func (r *pushSubscriptionResource) resolveConflict(ctx context.Context, plan pushSubscriptionResourceModel) (*strava.Subscription, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
+	existing, err := findPushSubscriptions(r.client, 0, "")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error importing item",
-			"Could not import item, unexpected error (The <id> part should be an integer): "+err.Error(),
+		diags.AddError(
+			"Error Reading Strava Subscriptions",
+			"Could not check for existing subscriptions, unexpected error: "+err.Error(),
 		)
-		return
+		return nil, diags
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verify_token"), verifyToken)...)
+	if len(existing) == 0 {
+		return nil, diags
+	}
+
+	switch plan.OnConflict.ValueString() {
+	case onConflictAdopt:
+		matches := filterPushSubscriptions(existing, 0, plan.CallbackURL.ValueString())
+		if len(matches) == 0 {
+			diags.AddAttributeError(
+				path.Root("on_conflict"),
+				"Cannot Adopt Strava Subscription",
+				fmt.Sprintf("The application already has push subscription ID %d with callback URL %q, "+
+					"which does not match the configured callback URL. Use on_conflict = \"replace\" to delete it instead.",
+					existing[0].ID, existing[0].CallbackURL),
+			)
+			return nil, diags
+		}
+
+		tflog.Warn(ctx, "Adopting existing push subscription", map[string]any{"id": matches[0].ID})
+		diags.AddWarning(
+			"Adopted Existing Strava Subscription",
+			fmt.Sprintf("Push subscription ID %d already existed with the configured callback URL and was adopted instead of creating a new one. "+
+				"Strava does not expose the verify token, so the configured verify_token is assumed to match.", matches[0].ID),
+		)
+
+		return &matches[0], diags
+	case onConflictReplace:
+		for _, subscription := range existing {
+			tflog.Warn(ctx, "Deleting existing push subscription", map[string]any{"id": subscription.ID})
+
+			err := r.client.DeleteSubscription(subscription.ID)
+			if err != nil {
+				diags.AddError(
+					"Error Deleting Strava Subscription",
+					fmt.Sprintf("Could not delete existing subscription ID %d, unexpected error: %s", subscription.ID, err),
+				)
+				return nil, diags
+			}
+
+			diags.AddWarning(
+				"Replaced Existing Strava Subscription",
+				fmt.Sprintf("Push subscription ID %d with callback URL %q was deleted so that a new one could be created.", subscription.ID, subscription.CallbackURL),
+			)
+		}
+
+		return nil, diags
+	default:
+		diags.AddAttributeError(
+			path.Root("on_conflict"),
+			"Strava Subscription Already Exists",
+			fmt.Sprintf("The application already has push subscription ID %d with callback URL %q, and Strava allows only one per application. "+
+				"Import it, or set on_conflict to \"adopt\" or \"replace\".", existing[0].ID, existing[0].CallbackURL),
+		)
+		return nil, diags
+	}
+}
+
+// preflightCheck runs the callback handshake check configured in the
+// preflight_check block.
+func (r *pushSubscriptionResource) preflightCheck(ctx context.Context, plan pushSubscriptionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	timeout := defaultPreflightTimeout
+	if !plan.PreflightCheck.Timeout.IsNull() {
+		var err error
+		timeout, err = time.ParseDuration(plan.PreflightCheck.Timeout.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("preflight_check").AtName("timeout"),
+				"Invalid Preflight Check Timeout",
+				"The timeout must be a duration such as \"2s\": "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	tflog.Debug(ctx, "Checking push subscription callback handshake", map[string]any{"callback_url": plan.CallbackURL.ValueString()})
+
+	result, err := checkCallbackHandshake(ctx, &http.Client{Transport: r.transport, Timeout: timeout}, plan.CallbackURL.ValueString(), plan.VerifyToken.ValueString())
+	if err != nil {
+		detail := "The callback endpoint " + plan.CallbackURL.ValueString() + " did not complete the Strava subscription handshake, " +
+			"so Strava would reject the subscription: " + err.Error()
+		if result != nil {
+			detail += "\n\n" + result.String()
+		}
+
+		diags.AddAttributeError(path.Root("callback_url"), "Callback Preflight Check Failed", detail)
+		return diags
+	}
+
+	tflog.Debug(ctx, "Push subscription callback handshake succeeded", map[string]any{"duration": result.Duration.String()})
+
+	return diags
 }
@@ -0,0 +1,363 @@
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/floydspace/strava-webhook-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiresReplaceUnlessImported(t *testing.T) {
+	testCases := map[string]struct {
+		state    types.String
+		expected bool
+	}{
+		"imported without token": {
+			state: types.StringNull(),
+		},
+		"token changed": {
+			state:    types.StringValue("STRAVA"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := planmodifier.StringRequest{
+				StateValue: testCase.state,
+				PlanValue:  types.StringValue("CHANGED"),
+			}
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+
+			requiresReplaceUnlessImported(context.Background(), req, resp)
+
+			if resp.RequiresReplace != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+// testPushSubscriptionServer fakes the Strava push subscription endpoints and
+// records the subscriptions created and deleted through them.
+type testPushSubscriptionServer struct {
+	client *strava.Client
+
+	mu            sync.Mutex
+	subscriptions []strava.Subscription
+	created       []string
+	deleted       []int
+}
+
+func newTestPushSubscriptionServer(t *testing.T, subscriptions ...strava.Subscription) *testPushSubscriptionServer {
+	t.Helper()
+
+	s := &testPushSubscriptionServer{subscriptions: subscriptions}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/push_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(s.subscriptions)
+		case http.MethodPost:
+			subscription := strava.Subscription{ID: 99, CallbackURL: r.URL.Query().Get("callback_url")}
+			s.subscriptions = append(s.subscriptions, subscription)
+			s.created = append(s.created, subscription.CallbackURL)
+
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(strava.Subscription{ID: subscription.ID})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/push_subscriptions/", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/push_subscriptions/"))
+		if err != nil || r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		remaining := []strava.Subscription{}
+		for _, subscription := range s.subscriptions {
+			if subscription.ID != id {
+				remaining = append(remaining, subscription)
+			}
+		}
+
+		s.subscriptions = remaining
+		s.deleted = append(s.deleted, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	s.client = &strava.Client{HostURL: server.URL, HTTPClient: server.Client()}
+
+	return s
+}
+
+// pushSubscriptionState returns a state of the strava_push_subscription
+// resource.
+func pushSubscriptionState(t *testing.T, model *pushSubscriptionResourceModel) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&pushSubscriptionResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if model != nil {
+		if diags := state.Set(ctx, model); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+	}
+
+	return state
+}
+
+// plannedPushSubscription returns the planned model of a new subscription.
+func plannedPushSubscription(callbackURL, onConflict string) *pushSubscriptionResourceModel {
+	return &pushSubscriptionResourceModel{
+		ID:            types.Int64Unknown(),
+		LastUpdated:   types.StringUnknown(),
+		ResourceState: types.Int64Unknown(),
+		ApplicationID: types.Int64Unknown(),
+		CallbackURL:   types.StringValue(callbackURL),
+		VerifyToken:   types.StringValue("STRAVA"),
+		CreatedAt:     types.StringUnknown(),
+		UpdatedAt:     types.StringUnknown(),
+		OnConflict:    types.StringValue(onConflict),
+	}
+}
+
+func TestPushSubscriptionResource_Create(t *testing.T) {
+	existing := []strava.Subscription{
+		{ID: 1, CallbackURL: "https://example.com/one"},
+		{ID: 2, CallbackURL: "https://example.com/two"},
+	}
+
+	testCases := map[string]struct {
+		existing    []strava.Subscription
+		callbackURL string
+		onConflict  string
+		expectedErr bool
+		expectedID  int64
+		created     []string
+		deleted     []int
+		warnings    []string
+	}{
+		"no conflict": {
+			callbackURL: "https://example.com/new",
+			onConflict:  onConflictFail,
+			expectedID:  99,
+			created:     []string{"https://example.com/new"},
+		},
+		"fail": {
+			existing:    existing,
+			callbackURL: "https://example.com/one",
+			onConflict:  onConflictFail,
+			expectedErr: true,
+		},
+		"adopt": {
+			existing:    existing,
+			callbackURL: "https://example.com/two",
+			onConflict:  onConflictAdopt,
+			expectedID:  2,
+			warnings:    []string{"ID 2"},
+		},
+		"adopt mismatch": {
+			existing:    existing,
+			callbackURL: "https://example.com/new",
+			onConflict:  onConflictAdopt,
+			expectedErr: true,
+		},
+		"replace": {
+			existing:    existing,
+			callbackURL: "https://example.com/new",
+			onConflict:  onConflictReplace,
+			expectedID:  99,
+			created:     []string{"https://example.com/new"},
+			deleted:     []int{1, 2},
+			warnings:    []string{"ID 1", "ID 2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newTestPushSubscriptionServer(t, testCase.existing...)
+			r := &pushSubscriptionResource{client: server.client}
+
+			planState := pushSubscriptionState(t, plannedPushSubscription(testCase.callbackURL, testCase.onConflict))
+			req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: planState.Schema, Raw: planState.Raw}}
+			resp := &resource.CreateResponse{State: pushSubscriptionState(t, nil)}
+
+			r.Create(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectedErr {
+				t.Fatalf("expected error %t, got: %v", testCase.expectedErr, resp.Diagnostics)
+			}
+
+			if !reflect.DeepEqual(server.created, testCase.created) {
+				t.Errorf("expected created subscriptions %v, got %v", testCase.created, server.created)
+			}
+
+			if !reflect.DeepEqual(server.deleted, testCase.deleted) {
+				t.Errorf("expected deleted subscriptions %v, got %v", testCase.deleted, server.deleted)
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if len(warnings) != len(testCase.warnings) {
+				t.Fatalf("expected %d warnings, got: %v", len(testCase.warnings), warnings)
+			}
+
+			for i, warning := range warnings {
+				if !strings.Contains(warning.Detail(), testCase.warnings[i]) {
+					t.Errorf("expected warning naming %s, got: %s", testCase.warnings[i], warning.Detail())
+				}
+			}
+
+			if testCase.expectedErr {
+				return
+			}
+
+			var state pushSubscriptionResourceModel
+			resp.State.Get(context.Background(), &state)
+
+			if state.ID.ValueInt64() != testCase.expectedID || state.CallbackURL.ValueString() != testCase.callbackURL {
+				t.Errorf("expected subscription %d with callback URL %s, got %d with %s",
+					testCase.expectedID, testCase.callbackURL, state.ID.ValueInt64(), state.CallbackURL.ValueString())
+			}
+		})
+	}
+}
+
+func TestPushSubscriptionResource_ReadRemovesDeleted(t *testing.T) {
+	server := newTestPushSubscriptionServer(t, strava.Subscription{ID: 2, CallbackURL: "https://example.com/two"})
+	r := &pushSubscriptionResource{client: server.client}
+
+	stored := plannedPushSubscription("https://example.com/one", onConflictFail)
+	stored.ID = types.Int64Value(1)
+	stored.LastUpdated = types.StringNull()
+	stored.ResourceState = types.Int64Null()
+	stored.ApplicationID = types.Int64Null()
+	stored.CreatedAt = types.StringNull()
+	stored.UpdatedAt = types.StringNull()
+
+	req := resource.ReadRequest{State: pushSubscriptionState(t, stored)}
+	resp := &resource.ReadResponse{State: req.State}
+
+	r.Read(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected the deleted subscription to be removed from state")
+	}
+}
+
+func TestPushSubscriptionResource_ImportState(t *testing.T) {
+	testCases := map[string]struct {
+		id                  string
+		expectedErr         bool
+		expectedID          int64
+		expectedVerifyToken types.String
+		expectedWarning     bool
+	}{
+		"id and verify token": {
+			id:                  "1,STRAVA",
+			expectedID:          1,
+			expectedVerifyToken: types.StringValue("STRAVA"),
+		},
+		"id": {
+			id:                  "1",
+			expectedID:          1,
+			expectedVerifyToken: types.StringNull(),
+			expectedWarning:     true,
+		},
+		"callback url": {
+			id:                  "callback_url=https://example.com/two",
+			expectedID:          2,
+			expectedVerifyToken: types.StringNull(),
+			expectedWarning:     true,
+		},
+		"unknown callback url": {
+			id:          "callback_url=https://example.com/new",
+			expectedErr: true,
+		},
+		"invalid id": {
+			id:          "one",
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newTestPushSubscriptionServer(t,
+				strava.Subscription{ID: 1, CallbackURL: "https://example.com/one"},
+				strava.Subscription{ID: 2, CallbackURL: "https://example.com/two"},
+			)
+			r := &pushSubscriptionResource{client: server.client}
+
+			resp := &resource.ImportStateResponse{State: pushSubscriptionState(t, nil)}
+			r.ImportState(context.Background(), resource.ImportStateRequest{ID: testCase.id}, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectedErr {
+				t.Fatalf("expected error %t, got: %v", testCase.expectedErr, resp.Diagnostics)
+			}
+
+			if testCase.expectedErr {
+				return
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != testCase.expectedWarning {
+				t.Errorf("expected warning %t, got: %v", testCase.expectedWarning, resp.Diagnostics)
+			}
+
+			var id types.Int64
+			var verifyToken types.String
+			resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+			resp.State.GetAttribute(context.Background(), path.Root("verify_token"), &verifyToken)
+
+			if id.ValueInt64() != testCase.expectedID || !verifyToken.Equal(testCase.expectedVerifyToken) {
+				t.Errorf("expected ID %d and verify token %s, got %d and %s", testCase.expectedID, testCase.expectedVerifyToken, id.ValueInt64(), verifyToken)
+			}
+		})
+	}
+}
@@ -134,5 +134,5 @@ func (d *pushSubscriptionsDataSource) Configure(_ context.Context, req datasourc
 		return
 	}
 
-	d.client = req.ProviderData.(*strava.Client)
+	d.client = req.ProviderData.(*stravaProviderData).client
 }
@@ -0,0 +1,303 @@
+package strava
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitWindow is the length of Strava's short-term rate limit window.
+	// Windows start at 0, 15, 30 and 45 minutes past the hour.
+	rateLimitWindow = 15 * time.Minute
+
+	// retryBaseWait is the backoff before the first retry, doubled for every
+	// following retry.
+	retryBaseWait = 1 * time.Second
+
+	defaultHTTPTimeout  = 10 * time.Second
+	defaultMaxRetries   = 3
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+var errRequestNotRewindable = errors.New("cannot retry request: body cannot be rewound")
+
+// retryTransport is an http.RoundTripper that retries failed Strava API
+// requests with jittered exponential backoff, and that keeps track of the
+// rate limit headers Strava returns to wait for the next short-term window
+// once its budget is used up.
+type retryTransport struct {
+	base http.RoundTripper
+
+	// timeout limits each attempt, including reading the response body.
+	// It replaces http.Client.Timeout, which would also count the time
+	// spent waiting between attempts.
+	timeout time.Duration
+	// maxRetries is the number of retries after the initial attempt.
+	maxRetries int
+	// maxWait caps the backoff between two attempts.
+	maxWait time.Duration
+	// respectRateLimit makes requests wait for the next short-term window
+	// when the rate limit headers show its budget is used up.
+	respectRateLimit bool
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu sync.Mutex
+	// shortTermResetAt is the start of the next short-term window, set when
+	// its budget is used up.
+	shortTermResetAt time.Time
+	// dailyResetAt is the next midnight UTC, set when the daily budget is
+	// used up.
+	dailyResetAt time.Time
+}
+
+// newRetryTransport wraps base with retries and rate limit handling.
+func newRetryTransport(base http.RoundTripper, timeout time.Duration, maxRetries int, maxWait time.Duration, respectRateLimit bool) *retryTransport {
+	return &retryTransport{
+		base:             base,
+		timeout:          timeout,
+		maxRetries:       maxRetries,
+		maxWait:          maxWait,
+		respectRateLimit: respectRateLimit,
+		now:              time.Now,
+		sleep:            sleepContext,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if t.respectRateLimit {
+			if err := t.sleep(ctx, t.rateLimitWait()); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := t.roundTripAttempt(req, attempt)
+		if err == nil {
+			t.observeRateLimit(res.Header)
+		}
+
+		if attempt >= t.maxRetries || ctx.Err() != nil || !t.shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		wait := t.backoff(attempt, res)
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// roundTripAttempt sends a single attempt of req, bounded by the timeout.
+func (t *retryTransport) roundTripAttempt(req *http.Request, attempt int) (*http.Response, error) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if t.timeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), t.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(req.Context())
+	}
+
+	attemptReq := req.Clone(ctx)
+	if attempt > 0 && req.Body != nil {
+		if req.GetBody == nil {
+			cancel()
+			return nil, errRequestNotRewindable
+		}
+
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		attemptReq.Body = body
+	}
+
+	res, err := t.base.RoundTrip(attemptReq)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+
+	return res, nil
+}
+
+// shouldRetry reports whether the attempt failed in a way worth retrying.
+func (t *retryTransport) shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		// Throttled requests were not processed, so they are safe to retry
+		// whatever the method, unless nothing is left for today.
+		return !t.dailyLimitExhausted()
+	case res.StatusCode >= 500:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns the time to wait before the next attempt.
+func (t *retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	// The short-term window wait happens before the next attempt.
+	if t.respectRateLimit && t.rateLimitWait() > 0 {
+		return 0
+	}
+
+	// A zero cap means retrying right away, and rand.Int63n panics on a
+	// negative argument.
+	if t.maxWait <= 0 {
+		return 0
+	}
+
+	wait := retryBaseWait << attempt
+	if wait <= 0 || wait > t.maxWait {
+		wait = t.maxWait
+	}
+
+	if res != nil {
+		if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			retryAfter := time.Duration(seconds) * time.Second
+			if retryAfter > t.maxWait {
+				retryAfter = t.maxWait
+			}
+
+			return retryAfter
+		}
+	}
+
+	// Full jitter over the upper half of the backoff.
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// observeRateLimit records the rate limit usage reported by Strava.
+//
+// The headers hold the short-term and the daily values separated by a comma,
+// e.g. "X-RateLimit-Limit: 600,30000" and "X-RateLimit-Usage: 314,27536".
+func (t *retryTransport) observeRateLimit(header http.Header) {
+	limits := parseRateLimitHeader(header.Get("X-RateLimit-Limit"))
+	usages := parseRateLimitHeader(header.Get("X-RateLimit-Usage"))
+	if len(limits) < 2 || len(usages) < 2 {
+		return
+	}
+
+	now := t.now().UTC()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if usages[0] >= limits[0] {
+		t.shortTermResetAt = now.Truncate(rateLimitWindow).Add(rateLimitWindow)
+	}
+
+	if usages[1] >= limits[1] {
+		t.dailyResetAt = now.Truncate(24 * time.Hour).Add(24 * time.Hour)
+	}
+}
+
+// rateLimitWait returns how long to wait for the short-term budget to reset.
+func (t *retryTransport) rateLimitWait() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	wait := t.shortTermResetAt.Sub(t.now())
+	if wait < 0 || t.dailyResetAt.After(t.now()) {
+		// Waiting for the short-term window is pointless when the daily
+		// budget is used up as well.
+		return 0
+	}
+
+	return wait
+}
+
+// dailyLimitExhausted reports whether the daily budget is used up.
+func (t *retryTransport) dailyLimitExhausted() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.dailyResetAt.After(t.now())
+}
+
+// parseRateLimitHeader parses a comma separated list of integers.
+func parseRateLimitHeader(value string) []int {
+	if value == "" {
+		return nil
+	}
+
+	var values []int
+	for _, part := range strings.Split(value, ",") {
+		v, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil
+		}
+
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// isIdempotent reports whether requests with the given method can be safely
+// sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancelOnCloseBody releases the attempt context once the response body is
+// closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer.
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,174 @@
+package strava
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport_RetriesServerErrors(t *testing.T) {
+	testCases := map[string]struct {
+		method           string
+		expectedAttempts int32
+		expectedStatus   int
+	}{
+		"idempotent": {
+			method:           http.MethodGet,
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		"non-idempotent": {
+			method:           http.MethodPost,
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusServiceUnavailable,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+			}))
+			defer server.Close()
+
+			transport := newRetryTransport(http.DefaultTransport, time.Second, 3, time.Second, true)
+			transport.sleep = func(context.Context, time.Duration) error { return nil }
+
+			req, _ := http.NewRequest(testCase.method, server.URL, nil)
+			res, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != testCase.expectedStatus {
+				t.Errorf("expected status %d, got %d", testCase.expectedStatus, res.StatusCode)
+			}
+
+			if attempts != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_WaitsForRateLimitWindow(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "600,30000")
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("X-RateLimit-Usage", "600,1200")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("X-RateLimit-Usage", "1,1201")
+	}))
+	defer server.Close()
+
+	now := time.Date(2023, 4, 1, 10, 7, 30, 0, time.UTC)
+
+	var waited time.Duration
+	transport := newRetryTransport(http.DefaultTransport, time.Second, 3, time.Second, true)
+	transport.now = func() time.Time { return now }
+	transport.sleep = func(_ context.Context, d time.Duration) error {
+		if d > 0 {
+			waited += d
+			now = now.Add(d)
+		}
+		return nil
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, nil)
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, res.StatusCode)
+	}
+
+	if expected := 7*time.Minute + 30*time.Second; waited != expected {
+		t.Errorf("expected to wait %s for the next window, waited %s", expected, waited)
+	}
+}
+
+func TestRetryTransport_DailyLimitExhausted(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("X-RateLimit-Limit", "600,30000")
+		w.Header().Set("X-RateLimit-Usage", "600,30000")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	transport := newRetryTransport(http.DefaultTransport, time.Second, 3, time.Second, true)
+	transport.sleep = func(context.Context, time.Duration) error { return nil }
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status %d, got %d", http.StatusTooManyRequests, res.StatusCode)
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	testCases := map[string]struct {
+		maxWait time.Duration
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		"first attempt": {
+			maxWait: 30 * time.Second,
+			min:     retryBaseWait / 2,
+			max:     retryBaseWait,
+		},
+		"capped": {
+			maxWait: 2 * time.Second,
+			attempt: 10,
+			min:     time.Second,
+			max:     2 * time.Second,
+		},
+		"zero max wait": {},
+		"negative max wait": {
+			maxWait: -time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := newRetryTransport(http.DefaultTransport, time.Second, 3, testCase.maxWait, false)
+
+			if got := transport.backoff(testCase.attempt, nil); got < testCase.min || got > testCase.max {
+				t.Errorf("expected a wait between %s and %s, got %s", testCase.min, testCase.max, got)
+			}
+		})
+	}
+}
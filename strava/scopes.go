@@ -0,0 +1,126 @@
+package strava
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// stravaScopes are the OAuth scopes an athlete can grant an application.
+var stravaScopes = []string{
+	"read",
+	"read_all",
+	"profile:read_all",
+	"profile:write",
+	"activity:read",
+	"activity:read_all",
+	"activity:write",
+}
+
+// parseScopes parses a comma separated list of scopes, such as the scope
+// parameter of the authorization redirect, ignoring spaces and empty items.
+func parseScopes(v string) []string {
+	var scopes []string
+	for _, scope := range strings.Split(v, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes
+}
+
+// unknownScopes returns the scopes that are not Strava OAuth scopes.
+func unknownScopes(scopes []string) []string {
+	known := map[string]bool{}
+	for _, scope := range stravaScopes {
+		known[scope] = true
+	}
+
+	var unknown []string
+	for _, scope := range scopes {
+		if !known[scope] {
+			unknown = append(unknown, scope)
+		}
+	}
+
+	return unknown
+}
+
+// missingScopes returns the required scopes that are not granted. Scopes
+// implied by a granted one, such as read by read_all, count as granted.
+func missingScopes(granted []string, required ...string) []string {
+	grantedSet := map[string]bool{}
+	for _, scope := range granted {
+		grantedSet[scope] = true
+		if implied, ok := impliedScopes[scope]; ok {
+			grantedSet[implied] = true
+		}
+	}
+
+	var missing []string
+	for _, scope := range required {
+		if !grantedSet[scope] {
+			missing = append(missing, scope)
+		}
+	}
+
+	return missing
+}
+
+// impliedScopes maps scopes to the narrower scope they include.
+var impliedScopes = map[string]string{
+	"read_all":          "read",
+	"activity:read_all": "activity:read",
+}
+
+// validateScopes reports an error on the attribute at p when the client is
+// known to lack any of the required scopes. Nothing is reported while the
+// provider is not configured or the granted scopes are unknown.
+func validateScopes(client *apiClient, p path.Path, required ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if client == nil || client.scopes == nil {
+		return diags
+	}
+
+	missing := missingScopes(client.scopes, required...)
+	if len(missing) == 0 {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		p,
+		"Missing Strava OAuth Scopes",
+		"This requires the "+strings.Join(missing, ", ")+" scopes, which the athlete did not grant. "+
+			"Authorize the application again with the authorize URL parameter scope="+scopeParameter(client.scopes, missing)+
+			", e.g. using the strava_authorization_url data source, then update the provider refresh_token and scopes.",
+	)
+
+	return diags
+}
+
+// scopeParameter returns the value of the authorize URL scope parameter
+// requesting both the granted and the missing scopes.
+func scopeParameter(granted, missing []string) string {
+	set := map[string]bool{}
+	for _, scope := range append(append([]string(nil), granted...), missing...) {
+		set[scope] = true
+	}
+
+	scopes := make([]string, 0, len(set))
+	for scope := range set {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+
+	return strings.Join(scopes, ",")
+}
+
+// withRequiredScopes is implemented by resources and data sources acting on
+// behalf of an athlete, declaring the OAuth scopes they require.
+type withRequiredScopes interface {
+	requiredScopes() []string
+}
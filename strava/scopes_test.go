@@ -0,0 +1,160 @@
+package strava
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestMissingScopes(t *testing.T) {
+	testCases := map[string]struct {
+		granted  []string
+		required []string
+		expected []string
+	}{
+		"granted": {
+			granted:  []string{"read", "profile:write"},
+			required: []string{"profile:write"},
+		},
+		"implied": {
+			granted:  []string{"read_all", "activity:read_all"},
+			required: []string{"read", "activity:read"},
+		},
+		"missing": {
+			granted:  []string{"read", "activity:read"},
+			required: []string{"activity:read_all", "profile:write", "read"},
+			expected: []string{"activity:read_all", "profile:write"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := missingScopes(testCase.granted, testCase.required...)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseScopes(t *testing.T) {
+	testCases := map[string]struct {
+		value    string
+		expected []string
+	}{
+		"comma separated": {
+			value:    "read,activity:read",
+			expected: []string{"read", "activity:read"},
+		},
+		"spaces": {
+			value:    " read, activity:read ",
+			expected: []string{"read", "activity:read"},
+		},
+		"empty items": {
+			value:    "read,,activity:read,",
+			expected: []string{"read", "activity:read"},
+		},
+		"blank": {
+			value: " , ",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := parseScopes(testCase.value)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnknownScopes(t *testing.T) {
+	got := unknownScopes([]string{"read", "activity:read", "activity:read_al", "write"})
+	expected := []string{"activity:read_al", "write"}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	testCases := map[string]struct {
+		client   *apiClient
+		expected string
+	}{
+		"unconfigured": {},
+		"unknown": {
+			client: &apiClient{},
+		},
+		"granted": {
+			client: &apiClient{scopes: []string{"read", "profile:write"}},
+		},
+		"missing": {
+			client:   &apiClient{scopes: []string{"read", "activity:read"}},
+			expected: "scope=activity:read,profile:write,read",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateScopes(testCase.client, path.Root("weight"), "profile:write")
+
+			if testCase.expected == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected error: %v", diags)
+				}
+				return
+			}
+
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got %v", diags)
+			}
+
+			if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, testCase.expected) {
+				t.Errorf("expected detail to contain %s, got %s", testCase.expected, detail)
+			}
+		})
+	}
+}
+
+func TestRequiredScopesAreKnown(t *testing.T) {
+	ctx := context.Background()
+
+	var declared []withRequiredScopes
+	for _, dataSource := range New().DataSources(ctx) {
+		if d, ok := dataSource().(withRequiredScopes); ok {
+			declared = append(declared, d)
+		}
+	}
+	for _, resource := range New().Resources(ctx) {
+		if r, ok := resource().(withRequiredScopes); ok {
+			declared = append(declared, r)
+		}
+	}
+
+	for _, d := range declared {
+		for _, scope := range d.requiredScopes() {
+			if missing := missingScopes(stravaScopes, scope); len(missing) > 0 {
+				t.Errorf("%T requires unknown scope %s", d, scope)
+			}
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package strava
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// tokenCacheKeyContext separates the cache encryption key from other uses of
+// the client secret.
+const tokenCacheKeyContext = "terraform-provider-strava token cache v1\x00"
+
+// tokenCache persists athlete tokens in a file shared by provider processes,
+// so that access tokens are reused across runs and refresh tokens rotated by
+// Strava are not lost. The file is encrypted with a key derived from the
+// client secret, and all access is serialized with an OS file lock.
+type tokenCache struct {
+	path string
+	key  [32]byte
+}
+
+// tokenCacheContents maps the decrypted contents of the cache file.
+type tokenCacheContents struct {
+	Entries map[string]tokenCacheEntry `json:"entries"`
+}
+
+// tokenCacheEntry holds the tokens of an athlete.
+type tokenCacheEntry struct {
+	// Source identifies the configured refresh token the entry descends
+	// from. Entries are ignored once another refresh token is configured.
+	Source string     `json:"source"`
+	Token  oauthToken `json:"token"`
+}
+
+// newTokenCache returns a cache stored at path and encrypted with a key
+// derived from the client secret.
+func newTokenCache(path, clientSecret string) *tokenCache {
+	return &tokenCache{
+		path: path,
+		key:  sha256.Sum256([]byte(tokenCacheKeyContext + clientSecret)),
+	}
+}
+
+// tokenCacheKey returns the cache entry key of the tokens descending from the
+// given refresh token of an application. Keying by the configured refresh
+// token rather than the athlete profile name keeps configurations sharing a
+// cache file from overwriting each other's rotated tokens.
+func tokenCacheKey(clientID, refreshToken string) string {
+	return clientID + "/" + tokenSourceID(refreshToken)
+}
+
+// tokenSourceID returns the identifier stored as the source of the entries
+// descending from the given refresh token.
+func tokenSourceID(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// get returns the entry stored under key, or nil if there is none.
+func (c *tokenCache) get(key string) (*tokenCacheEntry, error) {
+	var entry *tokenCacheEntry
+
+	err := c.update(key, func(current *tokenCacheEntry) (*tokenCacheEntry, error) {
+		entry = current
+		return nil, nil
+	})
+
+	return entry, err
+}
+
+// update calls fn with the entry stored under key, or nil if there is none,
+// while holding the cache lock, and stores the entry fn returns unless it is
+// nil. Other processes cannot access the cache until update returns.
+func (c *tokenCache) update(key string, fn func(entry *tokenCacheEntry) (*tokenCacheEntry, error)) error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+
+	unlock, err := lockFile(c.path + ".lock")
+	if err != nil {
+		return fmt.Errorf("locking token cache: %w", err)
+	}
+	defer unlock()
+
+	contents, err := c.read()
+	if err != nil {
+		return err
+	}
+
+	var current *tokenCacheEntry
+	if entry, ok := contents.Entries[key]; ok {
+		current = &entry
+	}
+
+	updated, err := fn(current)
+	if err != nil || updated == nil {
+		return err
+	}
+
+	contents.Entries[key] = *updated
+
+	return c.write(contents)
+}
+
+// read decrypts the cache file. A missing file, or one that cannot be
+// decrypted, e.g. because the client secret changed, reads as empty.
+func (c *tokenCache) read() (*tokenCacheContents, error) {
+	contents := &tokenCacheContents{Entries: map[string]tokenCacheEntry{}}
+
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return contents, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading token cache: %w", err)
+	}
+
+	plaintext, err := c.decrypt(data)
+	if err != nil {
+		return contents, nil
+	}
+
+	if err := json.Unmarshal(plaintext, contents); err != nil || contents.Entries == nil {
+		return &tokenCacheContents{Entries: map[string]tokenCacheEntry{}}, nil
+	}
+
+	return contents, nil
+}
+
+// write encrypts the contents and atomically replaces the cache file.
+func (c *tokenCache) write(contents *tokenCacheContents) error {
+	plaintext, err := json.Marshal(contents)
+	if err != nil {
+		return err
+	}
+
+	data, err := c.encrypt(plaintext)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("writing token cache: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing token cache: %w", err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing token cache: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing token cache: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		return fmt.Errorf("writing token cache: %w", err)
+	}
+
+	return nil
+}
+
+// encrypt seals plaintext with AES-GCM, prefixing the random nonce.
+func (c *tokenCache) encrypt(plaintext []byte) ([]byte, error) {
+	gcm, err := c.cipher()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// decrypt opens data sealed by encrypt.
+func (c *tokenCache) decrypt(data []byte) ([]byte, error) {
+	gcm, err := c.cipher()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("token cache is truncated")
+	}
+
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
+
+// cipher returns the AES-GCM cipher of the cache key.
+func (c *tokenCache) cipher() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(c.key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,177 @@
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenCache_Encrypted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens")
+	key := tokenCacheKey("5", "configured")
+
+	err := newTokenCache(path, "secret").update(key, func(*tokenCacheEntry) (*tokenCacheEntry, error) {
+		return &tokenCacheEntry{Source: "source", Token: oauthToken{AccessToken: "access", RefreshToken: "refresh"}}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entry, err := newTokenCache(path, "secret").get(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if entry == nil || entry.Token.RefreshToken != "refresh" {
+		t.Errorf("expected cached refresh token, got: %v", entry)
+	}
+
+	entry, err = newTokenCache(path, "another secret").get(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if entry != nil {
+		t.Errorf("expected no entry readable with another secret, got: %v", entry)
+	}
+}
+
+func TestTokenSource_SharesRotatedTokens(t *testing.T) {
+	var refreshes int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&refreshes, 1)
+		if r.FormValue("refresh_token") != "configured" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(oauthToken{
+			AccessToken:  "access-" + strconv.Itoa(int(n)),
+			RefreshToken: "rotated-" + strconv.Itoa(int(n)),
+			ExpiresAt:    time.Now().Add(6 * time.Hour).Unix(),
+		})
+	}))
+	defer server.Close()
+
+	oauth := &oauthClient{httpClient: server.Client(), baseURL: server.URL}
+	cache := newTokenCache(filepath.Join(t.TempDir(), "tokens"), "secret")
+	key := tokenCacheKey("5", "configured")
+
+	// A first process refreshes the configured token, which Strava rotates.
+	first := newTokenSource(oauth, "configured", "")
+	if err := first.useCache(cache, key); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := first.accessToken(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A second process reuses the cached access token.
+	second := newTokenSource(oauth, "configured", "")
+	if err := second.useCache(cache, key); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	accessToken, err := second.accessToken(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if accessToken != "access-1" {
+		t.Errorf("expected cached access token access-1, got %s", accessToken)
+	}
+
+	if refreshes != 1 {
+		t.Errorf("expected 1 refresh, got %d", refreshes)
+	}
+
+	if second.token.RefreshToken != "rotated-1" {
+		t.Errorf("expected rotated refresh token, got %s", second.token.RefreshToken)
+	}
+}
+
+func TestTokenSource_ContinuesWhenCacheWriteFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Replace the cache file with a directory so that writing it fails
+		// once the token is refreshed.
+		if err := os.RemoveAll(path); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+		if err := os.Mkdir(path, 0o700); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+
+		_ = json.NewEncoder(w).Encode(oauthToken{
+			AccessToken:  "access",
+			RefreshToken: "rotated",
+			ExpiresAt:    time.Now().Add(6 * time.Hour).Unix(),
+		})
+	}))
+	defer server.Close()
+
+	oauth := &oauthClient{httpClient: server.Client(), baseURL: server.URL}
+	tokens := newTokenSource(oauth, "configured", "")
+	if err := tokens.useCache(newTokenCache(path, "secret"), tokenCacheKey("5", "configured")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	accessToken, err := tokens.accessToken(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if accessToken != "access" || tokens.token.RefreshToken != "rotated" {
+		t.Errorf("expected refreshed tokens, got %s and %s", accessToken, tokens.token.RefreshToken)
+	}
+}
+
+func TestTokenSource_SharedCacheKeepsOtherRefreshTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		refreshToken := r.FormValue("refresh_token")
+
+		_ = json.NewEncoder(w).Encode(oauthToken{
+			AccessToken:  "access-" + refreshToken,
+			RefreshToken: "rotated-" + refreshToken,
+			ExpiresAt:    time.Now().Add(6 * time.Hour).Unix(),
+		})
+	}))
+	defer server.Close()
+
+	oauth := &oauthClient{httpClient: server.Client(), baseURL: server.URL}
+	path := filepath.Join(t.TempDir(), "tokens")
+
+	// Two configurations with the same client and profile name but different
+	// refresh tokens share one cache file.
+	for _, refreshToken := range []string{"first", "second"} {
+		tokens := newTokenSource(oauth, refreshToken, "")
+		if err := tokens.useCache(newTokenCache(path, "secret"), tokenCacheKey("5", refreshToken)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if _, err := tokens.accessToken(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// Neither refresh overwrote the tokens rotated by the other.
+	for _, refreshToken := range []string{"first", "second"} {
+		tokens := newTokenSource(oauth, refreshToken, "")
+		if err := tokens.useCache(newTokenCache(path, "secret"), tokenCacheKey("5", refreshToken)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if tokens.token.RefreshToken != "rotated-"+refreshToken {
+			t.Errorf("expected cached refresh token rotated-%s, got %s", refreshToken, tokens.token.RefreshToken)
+		}
+	}
+}